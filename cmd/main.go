@@ -4,7 +4,9 @@ import (
 	"flag"
 	"fmt"
 	"os"
+	"slices"
 	"strings"
+	"time"
 
 	"github.com/meddhiazoghlami/goxls/pkg/export"
 	"github.com/meddhiazoghlami/goxls/pkg/models"
@@ -13,15 +15,16 @@ import (
 
 // CLI options
 type options struct {
-	format    string
-	output    string
-	sheet     string
-	table     string
-	columns   string
-	sqlTable  string
-	summary   bool
-	pretty    bool
-	noHeaders bool
+	format     string
+	output     string
+	sheet      string
+	sheetIndex int
+	table      string
+	columns    string
+	sqlTable   string
+	summary    bool
+	pretty     bool
+	noHeaders  bool
 }
 
 func main() {
@@ -34,6 +37,11 @@ func main() {
 
 	filePath := flag.Arg(0)
 
+	if _, _, err := parseColumnSpec(opts.columns); err != nil {
+		fmt.Fprintf(os.Stderr, "Error: %v\n", err)
+		os.Exit(1)
+	}
+
 	// Create a workbook reader
 	wr := reader.NewWorkbookReader()
 
@@ -44,6 +52,11 @@ func main() {
 		os.Exit(1)
 	}
 
+	if err := checkSheetIndex(workbook, opts); err != nil {
+		fmt.Fprintf(os.Stderr, "Error: %v\n", err)
+		os.Exit(1)
+	}
+
 	// Filter tables based on options
 	tables := filterTables(workbook, opts)
 
@@ -92,9 +105,10 @@ func parseFlags() options {
 	flag.StringVar(&outputShort, "o", "", "Output file path (shorthand)")
 	flag.StringVar(&opts.sheet, "sheet", "", "Filter by sheet name")
 	flag.StringVar(&sheetShort, "s", "", "Filter by sheet name (shorthand)")
+	flag.IntVar(&opts.sheetIndex, "sheet-index", 0, "Filter by sheet position, starting at 1")
 	flag.StringVar(&opts.table, "table", "", "Filter by table name")
 	flag.StringVar(&tableShort, "t", "", "Filter by table name (shorthand)")
-	flag.StringVar(&opts.columns, "columns", "", "Comma-separated list of columns to include")
+	flag.StringVar(&opts.columns, "columns", "", "Comma-separated list of columns to include (prefix with ! or - to exclude)")
 	flag.StringVar(&columnsShort, "c", "", "Columns to include (shorthand)")
 	flag.StringVar(&opts.sqlTable, "sql-table", "data", "Table name for SQL output")
 	flag.BoolVar(&opts.summary, "summary", false, "Show summary only")
@@ -133,8 +147,9 @@ func printUsage() {
 	fmt.Println("  -f, --format <format>    Output format: json, csv, sql, text (default: text)")
 	fmt.Println("  -o, --output <file>      Output file path (default: stdout)")
 	fmt.Println("  -s, --sheet <name>       Filter by sheet name")
+	fmt.Println("      --sheet-index <n>    Filter by sheet position, 1 for the first sheet")
 	fmt.Println("  -t, --table <name>       Filter by table name")
-	fmt.Println("  -c, --columns <cols>     Comma-separated columns to include")
+	fmt.Println("  -c, --columns <cols>     Comma-separated columns to include, or !col to exclude")
 	fmt.Println("      --sql-table <name>   Table name for SQL output (default: data)")
 	fmt.Println("      --summary            Show summary information only")
 	fmt.Println("      --pretty             Pretty print JSON output")
@@ -145,10 +160,27 @@ func printUsage() {
 	fmt.Println("  goxls data.xlsx --format=json --pretty")
 	fmt.Println("  goxls data.xlsx -f csv -o output.csv")
 	fmt.Println("  goxls data.xlsx --sheet=Sales --columns=Name,Amount")
+	fmt.Println("  goxls data.xlsx --sheet-index=2 -f csv")
+	fmt.Println("  goxls data.xlsx --format=csv --columns=!Notes,!Internal")
 	fmt.Println("  goxls data.xlsx -f sql --sql-table=users")
 	fmt.Println("  goxls data.xlsx --summary")
 }
 
+// checkSheetIndex reports an error if --sheet-index is combined with --sheet
+// or does not name a sheet of the workbook
+func checkSheetIndex(wb *models.Workbook, opts options) error {
+	if opts.sheetIndex == 0 {
+		return nil
+	}
+	if opts.sheet != "" {
+		return fmt.Errorf("--sheet and --sheet-index cannot be used together")
+	}
+	if opts.sheetIndex < 1 || opts.sheetIndex > len(wb.Sheets) {
+		return fmt.Errorf("sheet index %d out of range: workbook has %d sheets", opts.sheetIndex, len(wb.Sheets))
+	}
+	return nil
+}
+
 func filterTables(wb *models.Workbook, opts options) []*models.Table {
 	var tables []*models.Table
 
@@ -159,6 +191,9 @@ func filterTables(wb *models.Workbook, opts options) []*models.Table {
 		if opts.sheet != "" && !strings.EqualFold(sheet.Name, opts.sheet) {
 			continue
 		}
+		if opts.sheetIndex > 0 && i != opts.sheetIndex-1 {
+			continue
+		}
 
 		for j := range sheet.Tables {
 			table := &sheet.Tables[j]
@@ -177,9 +212,12 @@ func filterTables(wb *models.Workbook, opts options) []*models.Table {
 
 func exportTables(tables []*models.Table, opts options) (string, error) {
 	// Parse selected columns
-	var selectedCols []string
-	if opts.columns != "" {
-		selectedCols = parseColumns(opts.columns)
+	selectedCols, excludedCols, err := parseColumnSpec(opts.columns)
+	if err != nil {
+		return "", err
+	}
+	if len(excludedCols) > 0 {
+		tables = dropColumns(tables, excludedCols)
 	}
 
 	// For single table, export directly
@@ -242,7 +280,21 @@ func exportTable(table *models.Table, opts options, selectedCols []string) (stri
 		return exporter.ExportString(table)
 
 	default:
-		return "", fmt.Errorf("unknown format: %s", opts.format)
+		// Formats added with export.RegisterFormat
+		format, err := export.ParseFormat(opts.format)
+		if err != nil {
+			return "", err
+		}
+		exportOpts := export.DefaultOptions()
+		exportOpts.IncludeHeaders = !opts.noHeaders
+		if len(selectedCols) > 0 {
+			exportOpts.SelectedColumns = selectedCols
+		}
+		exporter, err := export.NewExporter(format, &exportOpts)
+		if err != nil {
+			return "", err
+		}
+		return exporter.ExportString(table)
 	}
 }
 
@@ -258,9 +310,47 @@ func parseColumns(cols string) []string {
 	return result
 }
 
+// parseColumnSpec splits a --columns value into columns to include or, when every
+// entry starts with "!" or "-", columns to exclude. Only that one prefix
+// character is removed, so "!-Delta" excludes a column named "-Delta". Mixing
+// the two is an error.
+func parseColumnSpec(cols string) (include, exclude []string, err error) {
+	for _, col := range parseColumns(cols) {
+		if strings.HasPrefix(col, "!") || strings.HasPrefix(col, "-") {
+			if name := strings.TrimSpace(col[1:]); name != "" {
+				exclude = append(exclude, name)
+			}
+		} else {
+			include = append(include, col)
+		}
+	}
+	if len(include) > 0 && len(exclude) > 0 {
+		return nil, nil, fmt.Errorf("--columns cannot mix included and excluded (!col) columns")
+	}
+	return include, exclude, nil
+}
+
+// dropColumns returns copies of the tables without the excluded columns,
+// matched case-insensitively like the text view
+func dropColumns(tables []*models.Table, excluded []string) []*models.Table {
+	result := make([]*models.Table, len(tables))
+	for i, table := range tables {
+		kept := excludeHeaders(table.Headers, excluded)
+		var drop []string
+		for _, h := range table.Headers {
+			if !slices.Contains(kept, h) {
+				drop = append(drop, h)
+			}
+		}
+		result[i] = table.DropColumns(drop...)
+	}
+	return result
+}
+
 func printSummary(wb *models.Workbook, tables []*models.Table) {
 	fmt.Println("=== Workbook Summary ===")
 	fmt.Printf("File: %s\n", wb.FilePath)
+	printProperties(wb.Properties)
 	fmt.Printf("Sheets: %d\n", len(wb.Sheets))
 	fmt.Printf("Tables Found: %d\n\n", len(tables))
 
@@ -281,6 +371,27 @@ func printSummary(wb *models.Workbook, tables []*models.Table) {
 	}
 }
 
+func printProperties(props models.DocumentProperties) {
+	fields := []struct{ label, value string }{
+		{"Title", props.Title},
+		{"Creator", props.Creator},
+		{"Last Modified By", props.LastModifiedBy},
+		{"Company", props.Company},
+		{"Calculation", props.CalcMode},
+	}
+	if !props.Created.IsZero() {
+		fields = append(fields, struct{ label, value string }{"Created", props.Created.Format(time.RFC3339)})
+	}
+	if !props.Modified.IsZero() {
+		fields = append(fields, struct{ label, value string }{"Modified", props.Modified.Format(time.RFC3339)})
+	}
+	for _, f := range fields {
+		if f.value != "" {
+			fmt.Printf("%s: %s\n", f.label, f.value)
+		}
+	}
+}
+
 func cellTypeName(ct models.CellType) string {
 	switch ct {
 	case models.CellTypeString:
@@ -311,11 +422,8 @@ func printTextOutput(wb *models.Workbook, tables []*models.Table, opts options)
 	}
 	fmt.Printf("Total Tables Detected: %d\n\n", totalTables)
 
-	// Parse selected columns for filtering display
-	var selectedCols []string
-	if opts.columns != "" {
-		selectedCols = parseColumns(opts.columns)
-	}
+	// Parse selected columns for filtering display; the spec was validated in main
+	selectedCols, excludedCols, _ := parseColumnSpec(opts.columns)
 
 	// Group tables by sheet for display
 	sheetTables := make(map[string][]*models.Table)
@@ -352,32 +460,21 @@ func printTextOutput(wb *models.Workbook, tables []*models.Table, opts options)
 			displayHeaders := table.Headers
 			if len(selectedCols) > 0 {
 				displayHeaders = filterHeaders(table.Headers, selectedCols)
+			} else if len(excludedCols) > 0 {
+				displayHeaders = excludeHeaders(table.Headers, excludedCols)
 			}
 
 			fmt.Printf("  Headers: %v\n", displayHeaders)
 
 			// Print first few rows as sample
-			if len(table.Rows) > 0 {
+			if len(table.Rows) > 0 && len(displayHeaders) > 0 {
 				fmt.Println("  Sample Data (first 3 rows):")
-				maxRows := 3
-				if len(table.Rows) < maxRows {
-					maxRows = len(table.Rows)
-				}
-
-				for i := 0; i < maxRows; i++ {
-					row := table.Rows[i]
-					fmt.Printf("    Row %d: ", row.Index+1)
-					first := true
-					for _, header := range displayHeaders {
-						if cell, ok := row.Get(header); ok {
-							if !first {
-								fmt.Print(", ")
-							}
-							fmt.Printf("%s=%q", header, cell.AsString())
-							first = false
-						}
-					}
-					fmt.Println()
+				asciiOpts := export.DefaultASCIITableOptions()
+				asciiOpts.SelectedColumns = displayHeaders
+				asciiOpts.MaxRows = 3
+				asciiOpts.MaxColumnWidth = 30
+				for _, line := range strings.Split(strings.TrimSuffix(export.ToASCIITable(table, asciiOpts), "\n"), "\n") {
+					fmt.Printf("    %s\n", line)
 				}
 			}
 		}
@@ -399,3 +496,18 @@ func filterHeaders(headers []string, selected []string) []string {
 	}
 	return result
 }
+
+func excludeHeaders(headers []string, excluded []string) []string {
+	excludedMap := make(map[string]bool)
+	for _, s := range excluded {
+		excludedMap[strings.ToLower(s)] = true
+	}
+
+	var result []string
+	for _, h := range headers {
+		if !excludedMap[strings.ToLower(h)] {
+			result = append(result, h)
+		}
+	}
+	return result
+}
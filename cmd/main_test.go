@@ -0,0 +1,114 @@
+package main
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/meddhiazoghlami/goxls/pkg/models"
+	"github.com/meddhiazoghlami/goxls/pkg/reader"
+)
+
+func createCLITestTable() *models.Table {
+	return &models.Table{
+		Name:    "People",
+		Headers: []string{"Name", "Notes", "Internal", "Age"},
+		Rows: []models.Row{
+			{Index: 1, Values: map[string]models.Cell{
+				"Name":     {Value: "Alice", Type: models.CellTypeString, RawValue: "Alice"},
+				"Notes":    {Value: "call back", Type: models.CellTypeString, RawValue: "call back"},
+				"Internal": {Value: "x", Type: models.CellTypeString, RawValue: "x"},
+				"Age":      {Value: float64(30), Type: models.CellTypeNumber, RawValue: "30"},
+			}},
+		},
+	}
+}
+
+func TestParseColumnSpec(t *testing.T) {
+	tests := []struct {
+		spec        string
+		wantInclude []string
+		wantExclude []string
+		wantErr     bool
+	}{
+		{"", nil, nil, false},
+		{"Name, Age", []string{"Name", "Age"}, nil, false},
+		{"!Notes,-Internal", nil, []string{"Notes", "Internal"}, false},
+		{"Name,!Notes", nil, nil, true},
+		{"!-Delta,!notes", nil, []string{"-Delta", "notes"}, false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.spec, func(t *testing.T) {
+			include, exclude, err := parseColumnSpec(tt.spec)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("parseColumnSpec(%q) error = %v, wantErr %v", tt.spec, err, tt.wantErr)
+			}
+			if strings.Join(include, ",") != strings.Join(tt.wantInclude, ",") {
+				t.Errorf("include = %v, want %v", include, tt.wantInclude)
+			}
+			if strings.Join(exclude, ",") != strings.Join(tt.wantExclude, ",") {
+				t.Errorf("exclude = %v, want %v", exclude, tt.wantExclude)
+			}
+		})
+	}
+}
+
+func TestExportTables_ExcludeColumns(t *testing.T) {
+	table := createCLITestTable()
+	opts := options{format: "csv", columns: "!Notes,!Internal"}
+
+	output, err := exportTables([]*models.Table{table}, opts)
+	if err != nil {
+		t.Fatalf("exportTables() error = %v", err)
+	}
+
+	want := "Name,Age\nAlice,30\n"
+	if output != want {
+		t.Errorf("exportTables() = %q, want %q", output, want)
+	}
+	if len(table.Headers) != 4 {
+		t.Errorf("source table headers changed to %v", table.Headers)
+	}
+
+	// Excluded names match headers regardless of case, as in the text view
+	opts.columns = "!notes,!INTERNAL"
+	output, err = exportTables([]*models.Table{table}, opts)
+	if err != nil {
+		t.Fatalf("exportTables() error = %v", err)
+	}
+	if output != want {
+		t.Errorf("case-insensitive exportTables() = %q, want %q", output, want)
+	}
+
+	opts.columns = "Name,!Notes"
+	if _, err := exportTables([]*models.Table{table}, opts); err == nil {
+		t.Error("exportTables() mixing include and exclude should return an error")
+	}
+}
+
+func TestFilterTables_SheetIndex(t *testing.T) {
+	wb, err := reader.NewWorkbookReader().ReadFile("../testdata/sample.xlsx")
+	if err != nil {
+		t.Fatalf("ReadFile() error = %v", err)
+	}
+
+	opts := options{sheetIndex: 2}
+	if err := checkSheetIndex(wb, opts); err != nil {
+		t.Fatalf("checkSheetIndex() error = %v", err)
+	}
+	tables := filterTables(wb, opts)
+	if len(tables) == 0 {
+		t.Fatal("filterTables() returned no tables for the second sheet")
+	}
+	for _, table := range tables {
+		if !strings.HasPrefix(table.Name, wb.Sheets[1].Name+"_") {
+			t.Errorf("table %q is not from sheet %q", table.Name, wb.Sheets[1].Name)
+		}
+	}
+
+	for _, bad := range []options{{sheetIndex: len(wb.Sheets) + 1}, {sheetIndex: -1}, {sheetIndex: 1, sheet: "Simple"}} {
+		if err := checkSheetIndex(wb, bad); err == nil {
+			t.Errorf("checkSheetIndex(%+v) should return an error", bad)
+		}
+	}
+}
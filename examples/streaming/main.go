@@ -143,11 +143,8 @@ func aggregationExample() {
 	defer sr.Close()
 
 	// Track statistics while streaming
-	var (
-		rowCount   int
-		numericSum float64
-		numericCnt int
-	)
+	agg := goxls.NewAggregator()
+	rowCount := 0
 
 	for {
 		row, err := sr.Next()
@@ -160,21 +157,19 @@ func aggregationExample() {
 		}
 
 		rowCount++
-
-		// Sum any numeric values in the row
-		for _, cell := range row.Cells {
-			if val, ok := cell.AsFloat(); ok {
-				numericSum += val
-				numericCnt++
-			}
-		}
+		agg.Add(row)
 	}
 
 	fmt.Printf("Total rows: %d\n", rowCount)
-	fmt.Printf("Numeric cells found: %d\n", numericCnt)
-	if numericCnt > 0 {
-		fmt.Printf("Sum of numeric values: %.2f\n", numericSum)
-		fmt.Printf("Average: %.2f\n", numericSum/float64(numericCnt))
+	for _, header := range sr.Headers() {
+		avg, ok := agg.AvgColumn(header)
+		if !ok {
+			continue
+		}
+		minVal, _ := agg.Min(header)
+		maxVal, _ := agg.Max(header)
+		fmt.Printf("%s: sum=%.2f avg=%.2f min=%.2f max=%.2f\n",
+			header, agg.SumColumn(header), avg, minVal, maxVal)
 	}
 	fmt.Println()
 }
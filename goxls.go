@@ -44,9 +44,24 @@ type (
 	// Workbook represents an entire Excel file
 	Workbook = models.Workbook
 
+	// DocumentProperties holds a workbook's author, date and calculation metadata
+	DocumentProperties = models.DocumentProperties
+
 	// Sheet represents a worksheet within a workbook
 	Sheet = models.Sheet
 
+	// ExcelTableRef is an Excel Table (ListObject) or AutoFilter range defined in the file
+	ExcelTableRef = models.ExcelTableRef
+
+	// SheetVisibility represents whether a sheet is shown in Excel
+	SheetVisibility = models.SheetVisibility
+
+	// NumberLocale selects the separators used to parse numbers stored as text
+	NumberLocale = models.NumberLocale
+
+	// MergeFill selects which cells of a merged range hold its value
+	MergeFill = models.MergeFill
+
 	// Table represents a detected table within a sheet
 	Table = models.Table
 
@@ -65,6 +80,9 @@ type (
 	// ColumnStats represents statistical analysis for a column
 	ColumnStats = models.ColumnStats
 
+	// AnalyzeOptions configures column analysis
+	AnalyzeOptions = models.AnalyzeOptions
+
 	// DetectionConfig holds configuration for table detection
 	DetectionConfig = models.DetectionConfig
 
@@ -80,6 +98,21 @@ type (
 	// NamedRange represents an Excel named range
 	NamedRange = models.NamedRange
 
+	// ColumnResolver matches loosely written column names to a table's headers
+	ColumnResolver = models.ColumnResolver
+
+	// TableSummary is a per-column profile of a table returned by Table.Describe
+	TableSummary = models.TableSummary
+
+	// ColumnSummary profiles one column of a TableSummary
+	ColumnSummary = models.ColumnSummary
+
+	// Location identifies a row by sheet, table and position
+	Location = models.Location
+
+	// TableBuilder builds tables in code from Go values
+	TableBuilder = models.TableBuilder
+
 	// Template represents an expected Excel file structure for validation
 	Template = validation.Template
 
@@ -101,6 +134,21 @@ type (
 	// SchemaBuilder provides a fluent API for building sheet schemas
 	SchemaBuilder = validation.SchemaBuilder
 
+	// ValidationRule defines a validation constraint for a column
+	ValidationRule = validation.ValidationRule
+
+	// TableRule defines a constraint on a whole table
+	TableRule = validation.TableRule
+
+	// ValidationResult contains the results of row-level validation
+	ValidationResult = validation.ValidationResult
+
+	// ValidationError represents a single failed cell or table check
+	ValidationError = validation.ValidationError
+
+	// RuleBuilder provides a fluent API for building validation rules
+	RuleBuilder = validation.RuleBuilder
+
 	// SchemaOptions configures Go struct generation from tables
 	SchemaOptions = schema.SchemaOptions
 
@@ -127,6 +175,9 @@ type (
 
 	// StreamOption is a functional option for configuring the stream reader
 	StreamOption = stream.StreamOption
+
+	// Aggregator accumulates per-column statistics while streaming rows
+	Aggregator = stream.Aggregator
 )
 
 // Re-export CellType constants
@@ -139,6 +190,28 @@ const (
 	CellTypeFormula = models.CellTypeFormula
 )
 
+// Re-export NumberLocale constants
+const (
+	LocaleNone  = models.LocaleNone
+	LocaleUS    = models.LocaleUS
+	LocaleEU    = models.LocaleEU
+	LocaleSpace = models.LocaleSpace
+)
+
+// Re-export MergeFill constants
+const (
+	FillAll        = models.FillAll
+	FillOriginOnly = models.FillOriginOnly
+	FillNone       = models.FillNone
+)
+
+// Re-export SheetVisibility constants
+const (
+	VisibilityVisible    = models.VisibilityVisible
+	VisibilityHidden     = models.VisibilityHidden
+	VisibilityVeryHidden = models.VisibilityVeryHidden
+)
+
 // Re-export AggregateOp constants for aggregation operations
 const (
 	AggSum   = models.AggSum
@@ -204,6 +277,12 @@ var (
 	// ErrInvalidFormat is returned when the file is not a valid Excel file
 	ErrInvalidFormat = errors.New("goxls: invalid file format")
 
+	// ErrLegacyXLSFormat is returned for binary .xls workbooks, which must be saved as .xlsx
+	ErrLegacyXLSFormat = reader.ErrLegacyXLSFormat
+
+	// ErrWrongPassword is returned when WithPassword does not open a protected workbook
+	ErrWrongPassword = reader.ErrWrongPassword
+
 	// ErrSheetNotFound is returned when the requested sheet does not exist
 	ErrSheetNotFound = errors.New("goxls: sheet not found")
 
@@ -233,6 +312,9 @@ type Option func(*options)
 type options struct {
 	config   models.DetectionConfig
 	parallel bool
+	progress reader.ProgressFunc
+	sheets   []string
+	password string
 }
 
 // defaultOptions returns the default options
@@ -264,6 +346,13 @@ func WithMaxEmptyRows(n int) Option {
 	}
 }
 
+// WithTableSeparatorRows sets how many blank rows separate two tables on a sheet
+func WithTableSeparatorRows(n int) Option {
+	return func(o *options) {
+		o.config.TableSeparatorRows = n
+	}
+}
+
 // WithHeaderDensity sets the minimum density of non-empty cells for header detection
 func WithHeaderDensity(d float64) Option {
 	return func(o *options) {
@@ -292,6 +381,170 @@ func WithTrackMergeMetadata(track bool) Option {
 	}
 }
 
+// WithSkipHidden enables/disables omitting hidden rows and columns from extracted tables
+func WithSkipHidden(skip bool) Option {
+	return func(o *options) {
+		o.config.SkipHidden = skip
+	}
+}
+
+// WithSkipHiddenSheets enables/disables omitting hidden and very hidden sheets
+func WithSkipHiddenSheets(skip bool) Option {
+	return func(o *options) {
+		o.config.SkipHiddenSheets = skip
+	}
+}
+
+// WithFlattenHeaders combines multi-row (merged group) headers into single column
+// names joined by separator, e.g. "Group A > Sub1". An empty separator uses " > ".
+func WithFlattenHeaders(separator string) Option {
+	return func(o *options) {
+		o.config.FlattenHierarchicalHeaders = true
+		o.config.HeaderSeparator = separator
+	}
+}
+
+// WithDetectTransposed enables/disables reading tables with headers down the first column
+func WithDetectTransposed(detect bool) Option {
+	return func(o *options) {
+		o.config.DetectTransposed = detect
+	}
+}
+
+// WithMergeContinuationRows appends wrapped-text rows to the row above
+func WithMergeContinuationRows(enabled bool) Option {
+	return func(o *options) {
+		o.config.MergeContinuationRows = enabled
+	}
+}
+
+// WithTrustExcelTypes takes cell types from the file instead of inferring them from values
+func WithTrustExcelTypes(enabled bool) Option {
+	return func(o *options) {
+		o.config.TrustExcelTypes = enabled
+	}
+}
+
+// WithCoalesceHeaderWords joins header words stacked over two rows, e.g. "First" over "Name"
+func WithCoalesceHeaderWords(enabled bool) Option {
+	return func(o *options) {
+		o.config.CoalesceHeaderWords = enabled
+	}
+}
+
+// WithPreferNativeTables uses Excel Table and AutoFilter ranges from the file in place of detected tables
+func WithPreferNativeTables(enabled bool) Option {
+	return func(o *options) {
+		o.config.PreferNativeTables = enabled
+	}
+}
+
+// WithStreamingSheetRead builds each sheet's grid while iterating rows rather than loading all rows first
+func WithStreamingSheetRead(enabled bool) Option {
+	return func(o *options) {
+		o.config.StreamingSheetRead = enabled
+	}
+}
+
+// WithNumberLocale parses numbers stored as text using the locale's grouping and decimal separators
+func WithNumberLocale(locale NumberLocale) Option {
+	return func(o *options) {
+		o.config.NumberLocale = locale
+	}
+}
+
+// WithBoolValues reads the given strings as booleans, e.g. WithBoolValues([]string{"Yes"}, []string{"No"})
+func WithBoolValues(trueValues, falseValues []string) Option {
+	return func(o *options) {
+		o.config.BoolTrueValues = trueValues
+		o.config.BoolFalseValues = falseValues
+	}
+}
+
+// WithMergeFill selects which cells of a merged range receive its value
+func WithMergeFill(fill MergeFill) Option {
+	return func(o *options) {
+		o.config.MergeFill = fill
+	}
+}
+
+// WithTableNamer names detected tables with fn instead of "<sheet>_Table<n>"
+func WithTableNamer(fn func(sheet string, index int, headers []string) string) Option {
+	return func(o *options) {
+		o.config.TableNamer = fn
+	}
+}
+
+// WithCaptureTitles stores a lone caption line above each table's header as Table.Title
+func WithCaptureTitles(enabled bool) Option {
+	return func(o *options) {
+		o.config.CaptureTitles = enabled
+	}
+}
+
+// WithMaxScanColumns ignores values beyond the first n columns of each sheet,
+// so a stray cell far to the right does not widen the grid (0 = no limit)
+func WithMaxScanColumns(n int) Option {
+	return func(o *options) {
+		o.config.MaxScanColumns = n
+	}
+}
+
+// WithKeepTrailingEmptyColumns widens tables to data columns past the end of
+// the header row, naming them Column_N
+func WithKeepTrailingEmptyColumns(enabled bool) Option {
+	return func(o *options) {
+		o.config.KeepTrailingEmptyColumns = enabled
+	}
+}
+
+// WithDuplicateHeaderResolver names repeated headers with fn instead of
+// appending a count such as "Name_2"
+func WithDuplicateHeaderResolver(fn func(name string, colIndex int) string) Option {
+	return func(o *options) {
+		o.config.DuplicateHeaderResolver = fn
+	}
+}
+
+// WithRemoveRepeatedHeaders drops data rows that repeat the header row, as in
+// paginated report exports
+func WithRemoveRepeatedHeaders(enabled bool) Option {
+	return func(o *options) {
+		o.config.RemoveRepeatedHeaders = enabled
+	}
+}
+
+// WithForceStringColumns keeps the named columns as text, preserving values
+// such as leading zeros
+func WithForceStringColumns(columns ...string) Option {
+	return func(o *options) {
+		o.config.ForceStringColumns = columns
+	}
+}
+
+// WithStripTextMarker enables/disables reading cells with Excel's quotePrefix
+// text marker as strings, e.g. '007 as the string "007"
+func WithStripTextMarker(enabled bool) Option {
+	return func(o *options) {
+		o.config.StripTextMarker = enabled
+	}
+}
+
+// WithIgnoreTablesWithoutHeaders drops detected tables without a header
+// containing one of the keywords, e.g. small metadata blocks
+func WithIgnoreTablesWithoutHeaders(keywords ...string) Option {
+	return func(o *options) {
+		o.config.IgnoreTablesWithoutHeaders = keywords
+	}
+}
+
+// WithAcceptTable keeps only the detected tables for which fn returns true
+func WithAcceptTable(fn func(table *models.Table) bool) Option {
+	return func(o *options) {
+		o.config.AcceptTable = fn
+	}
+}
+
 // WithParallel enables/disables parallel sheet processing
 func WithParallel(parallel bool) Option {
 	return func(o *options) {
@@ -299,6 +552,45 @@ func WithParallel(parallel bool) Option {
 	}
 }
 
+// WithProgress registers a callback invoked after each sheet is processed.
+// done counts finished sheets and total is the number of sheets in the workbook.
+// For parallel reads, callback invocations are serialized.
+//
+// Example:
+//
+//	workbook, err := goxls.ReadFile("data.xlsx", goxls.WithProgress(func(sheet string, done, total int) {
+//	    fmt.Printf("%s (%d/%d)\n", sheet, done, total)
+//	}))
+func WithProgress(fn func(sheetName string, done, total int)) Option {
+	return func(o *options) {
+		o.progress = fn
+	}
+}
+
+// WithSheets restricts reading to the named sheets.
+// Other sheets are neither loaded into grids nor analyzed. Combines with WithParallel.
+//
+// Example:
+//
+//	workbook, err := goxls.ReadFile("data.xlsx", goxls.WithSheets("Sales", "Inventory"))
+func WithSheets(names ...string) Option {
+	return func(o *options) {
+		o.sheets = names
+	}
+}
+
+// WithPassword sets the password used to open encrypted workbooks.
+// A password that does not open the file returns ErrWrongPassword.
+//
+// Example:
+//
+//	workbook, err := goxls.ReadFile("protected.xlsx", goxls.WithPassword("secret"))
+func WithPassword(password string) Option {
+	return func(o *options) {
+		o.password = password
+	}
+}
+
 // WithConfig sets the full detection configuration
 func WithConfig(config DetectionConfig) Option {
 	return func(o *options) {
@@ -328,6 +620,9 @@ func ReadFile(filePath string, opts ...Option) (*Workbook, error) {
 
 	// Create reader with config
 	wr := reader.NewWorkbookReaderWithConfig(o.config)
+	wr.SetProgress(o.progress)
+	wr.SetSheets(o.sheets...)
+	wr.SetPassword(o.password)
 
 	// Read file
 	var workbook *Workbook
@@ -346,6 +641,26 @@ func ReadFile(filePath string, opts ...Option) (*Workbook, error) {
 	return workbook, nil
 }
 
+// ReadFiles reads several Excel files into a single workbook.
+// Each sheet records its source file, and Workbook.ConcatTables combines
+// same-named tables across the files.
+//
+// Example:
+//
+//	workbook, err := goxls.ReadFiles([]string{"q1.xlsx", "q2.xlsx"})
+//	sales := workbook.ConcatTables("Sales_Table1")
+func ReadFiles(filePaths []string, opts ...Option) (*Workbook, error) {
+	workbooks := make([]*Workbook, 0, len(filePaths))
+	for _, filePath := range filePaths {
+		wb, err := ReadFile(filePath, opts...)
+		if err != nil {
+			return nil, err
+		}
+		workbooks = append(workbooks, wb)
+	}
+	return (&Workbook{}).Merge(workbooks...), nil
+}
+
 // ReadFileWithContext reads an Excel file with context support for cancellation.
 // The context can be used to cancel long-running operations.
 //
@@ -403,12 +718,13 @@ func ReadSheet(filePath, sheetName string, opts ...Option) (*Sheet, error) {
 
 	// Create reader with config
 	wr := reader.NewWorkbookReaderWithConfig(o.config)
+	wr.SetPassword(o.password)
 
 	// Read sheet
 	sheet, err := wr.ReadSheet(filePath, sheetName)
 	if err != nil {
 		// Check if it's a "sheet not found" error
-		if sheet == nil {
+		if sheet == nil && !errors.Is(err, ErrWrongPassword) {
 			return nil, fmt.Errorf("%w: %s", ErrSheetNotFound, sheetName)
 		}
 		return nil, wrapError(err)
@@ -507,6 +823,10 @@ func wrapError(err error) error {
 		return nil
 	}
 
+	if errors.Is(err, ErrLegacyXLSFormat) {
+		return fmt.Errorf("%w: %w", ErrInvalidFormat, err)
+	}
+
 	errStr := err.Error()
 
 	// Check for common error patterns
@@ -619,6 +939,27 @@ func ValidateColumns(table *Table, requiredColumns ...string) []string {
 	return validation.ValidateColumns(table, requiredColumns...)
 }
 
+// ValidateTable checks every row of a table against the given rules.
+//
+// Example:
+//
+//	result := goxls.ValidateTable(table,
+//	    goxls.ForColumn("Email").Required().Build(),
+//	    goxls.ForColumn("Age").Min(0).Build(),
+//	)
+//	for _, err := range result.Errors {
+//	    fmt.Println(err)
+//	}
+func ValidateTable(table *Table, rules ...ValidationRule) *ValidationResult {
+	result := validation.ValidateTable(table, rules)
+	return &result
+}
+
+// ForColumn starts building a validation rule for a column.
+func ForColumn(name string) *RuleBuilder {
+	return validation.ForColumn(name)
+}
+
 // --- Schema Generation Functions ---
 
 // GenerateStruct creates a Go struct definition from a table's headers and inferred types.
@@ -769,6 +1110,28 @@ func NewStreamReaderWithContext(ctx context.Context, filePath, sheetName string,
 	return stream.NewStreamReaderWithContext(ctx, filePath, sheetName, opts...)
 }
 
+// NewAggregator creates a streaming aggregator for the given columns.
+// With no columns, every column seen in the rows is tracked.
+func NewAggregator(columns ...string) *Aggregator {
+	return stream.NewAggregator(columns...)
+}
+
+// NewColumnResolver creates a resolver that matches column names to the given
+// headers case-insensitively and ignoring spaces and punctuation.
+func NewColumnResolver(headers []string) *ColumnResolver {
+	return models.NewColumnResolver(headers)
+}
+
+// NewRow creates an empty row with the given index for building tables in code.
+func NewRow(index int) Row {
+	return models.NewRow(index)
+}
+
+// NewTableBuilder creates a builder for a table with the given name.
+func NewTableBuilder(name string) *TableBuilder {
+	return models.NewTableBuilder(name)
+}
+
 // DefaultStreamConfig returns the default streaming configuration.
 func DefaultStreamConfig() StreamConfig {
 	return stream.DefaultStreamConfig()
@@ -3,6 +3,8 @@ package goxls
 import (
 	"context"
 	"errors"
+	"os"
+	"path/filepath"
 	"testing"
 	"time"
 )
@@ -34,6 +36,55 @@ func TestReadFileNotFound(t *testing.T) {
 	}
 }
 
+func TestReadFiles(t *testing.T) {
+	data, err := os.ReadFile("testdata/sample.xlsx")
+	if err != nil {
+		t.Fatalf("failed to read sample: %v", err)
+	}
+	copyPath := filepath.Join(t.TempDir(), "sample_copy.xlsx")
+	if err := os.WriteFile(copyPath, data, 0644); err != nil {
+		t.Fatalf("failed to write copy: %v", err)
+	}
+
+	single, err := ReadFile("testdata/sample.xlsx")
+	if err != nil {
+		t.Fatalf("ReadFile failed: %v", err)
+	}
+	merged, err := ReadFiles([]string{"testdata/sample.xlsx", copyPath})
+	if err != nil {
+		t.Fatalf("ReadFiles failed: %v", err)
+	}
+
+	n := len(single.Sheets)
+	if len(merged.Sheets) != 2*n {
+		t.Fatalf("len(Sheets) = %d, want %d", len(merged.Sheets), 2*n)
+	}
+	for i, sheet := range merged.Sheets {
+		want := "testdata/sample.xlsx"
+		if i >= n {
+			want = copyPath
+		}
+		if sheet.SourceFile != want {
+			t.Errorf("Sheets[%d].SourceFile = %q, want %q", i, sheet.SourceFile, want)
+		}
+	}
+
+	if len(single.Sheets) > 0 && len(single.Sheets[0].Tables) > 0 {
+		first := single.Sheets[0].Tables[0]
+		concat := merged.ConcatTables(first.Name)
+		if concat == nil {
+			t.Fatalf("ConcatTables(%q) returned nil", first.Name)
+		}
+		if concat.RowCount() != 2*first.RowCount() {
+			t.Errorf("ConcatTables(%q).RowCount() = %d, want %d", first.Name, concat.RowCount(), 2*first.RowCount())
+		}
+	}
+
+	if _, err := ReadFiles([]string{"testdata/sample.xlsx", "nonexistent.xlsx"}); !errors.Is(err, ErrFileNotFound) {
+		t.Errorf("Expected ErrFileNotFound, got: %v", err)
+	}
+}
+
 func TestReadFileWithOptions(t *testing.T) {
 	workbook, err := ReadFile("testdata/sample.xlsx",
 		WithMinColumns(2),
@@ -50,6 +101,48 @@ func TestReadFileWithOptions(t *testing.T) {
 	}
 }
 
+func TestReadFile_CaptureTitles(t *testing.T) {
+	sheetNamed := func(wb *Workbook, name string) *Sheet {
+		for i := range wb.Sheets {
+			if wb.Sheets[i].Name == name {
+				return &wb.Sheets[i]
+			}
+		}
+		t.Fatalf("sheet %q not found", name)
+		return nil
+	}
+
+	workbook, err := ReadFile("testdata/sample.xlsx", WithCaptureTitles(true))
+	if err != nil {
+		t.Fatalf("ReadFile() error = %v", err)
+	}
+
+	sheet := sheetNamed(workbook, "Offset")
+	if sheet == nil || len(sheet.Tables) == 0 {
+		t.Fatal("Offset sheet has no tables")
+	}
+	table := sheet.Tables[0]
+	if table.Title != "Report Generated: 2024-01-15" {
+		t.Errorf("Title = %q, want %q", table.Title, "Report Generated: 2024-01-15")
+	}
+	if len(table.Headers) == 0 || table.Headers[0] != "Product" {
+		t.Errorf("Headers = %v, want the table below the title", table.Headers)
+	}
+
+	// Tables directly at the top of a sheet have no title
+	if title := sheetNamed(workbook, "Simple").Tables[0].Title; title != "" {
+		t.Errorf("Simple Title = %q, want empty", title)
+	}
+
+	workbook, err = ReadFile("testdata/sample.xlsx")
+	if err != nil {
+		t.Fatalf("ReadFile() error = %v", err)
+	}
+	if title := sheetNamed(workbook, "Offset").Tables[0].Title; title != "" {
+		t.Errorf("Title without CaptureTitles = %q, want empty", title)
+	}
+}
+
 func TestReadFileParallel(t *testing.T) {
 	workbook, err := ReadFile("testdata/sample.xlsx", WithParallel(true))
 	if err != nil {
@@ -242,6 +335,8 @@ func TestSentinelErrors(t *testing.T) {
 	errors := []error{
 		ErrFileNotFound,
 		ErrInvalidFormat,
+		ErrLegacyXLSFormat,
+		ErrWrongPassword,
 		ErrSheetNotFound,
 		ErrNoTablesFound,
 		ErrInvalidRange,
@@ -256,6 +351,39 @@ func TestSentinelErrors(t *testing.T) {
 	}
 }
 
+func TestReadFile_WithPassword(t *testing.T) {
+	// testdata/protected.xlsx is encrypted with the password "goxls"
+	workbook, err := ReadFile("testdata/protected.xlsx", WithPassword("goxls"))
+	if err != nil {
+		t.Fatalf("ReadFile() error = %v", err)
+	}
+	if n := workbook.Sheets[0].Tables[0].RowCount(); n != 2 {
+		t.Errorf("RowCount() = %d, want 2", n)
+	}
+
+	if _, err := ReadFile("testdata/protected.xlsx", WithPassword("wrong")); !errors.Is(err, ErrWrongPassword) {
+		t.Errorf("ReadFile() with wrong password error = %v, want ErrWrongPassword", err)
+	}
+	if _, err := ReadSheet("testdata/protected.xlsx", "Sheet1", WithPassword("wrong")); !errors.Is(err, ErrWrongPassword) {
+		t.Errorf("ReadSheet() with wrong password error = %v, want ErrWrongPassword", err)
+	}
+}
+
+func TestReadFile_LegacyXLS(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "legacy.xls")
+	if err := os.WriteFile(path, []byte{0xD0, 0xCF, 0x11, 0xE0, 0xA1, 0xB1, 0x1A, 0xE1}, 0644); err != nil {
+		t.Fatalf("Failed to create test file: %v", err)
+	}
+
+	_, err := ReadFile(path)
+	if !errors.Is(err, ErrLegacyXLSFormat) {
+		t.Errorf("ReadFile() error = %v, want ErrLegacyXLSFormat", err)
+	}
+	if !errors.Is(err, ErrInvalidFormat) {
+		t.Errorf("ReadFile() error = %v, want it to also match ErrInvalidFormat", err)
+	}
+}
+
 func TestFunctionalOptions(t *testing.T) {
 	opts := defaultOptions()
 
@@ -331,3 +459,67 @@ func TestDiffTables(t *testing.T) {
 		t.Errorf("Expected 1 removed row, got %d", len(diff.RemovedRows))
 	}
 }
+
+func TestValidateTable(t *testing.T) {
+	table := &Table{
+		Name:    "Users",
+		Headers: []string{"ID", "Email"},
+		Rows: []Row{
+			{Index: 1, Values: map[string]Cell{"ID": {Type: CellTypeString, Value: "1", RawValue: "1"}, "Email": {Type: CellTypeString, Value: "a@x.com", RawValue: "a@x.com"}}},
+			{Index: 2, Values: map[string]Cell{"ID": {Type: CellTypeString, Value: "2", RawValue: "2"}, "Email": {Type: CellTypeEmpty}}},
+			{Index: 3, Values: map[string]Cell{"ID": {Type: CellTypeString, Value: "3", RawValue: "3"}}},
+		},
+	}
+
+	result := ValidateTable(table, ForColumn("Email").Required().Build())
+
+	if result.Valid {
+		t.Fatal("Expected validation to fail for missing emails")
+	}
+	if len(result.Errors) != 2 {
+		t.Fatalf("Expected 2 errors, got %d: %v", len(result.Errors), result.Errors)
+	}
+	for i, wantRow := range []int{1, 2} {
+		if result.Errors[i].Row != wantRow || result.Errors[i].Column != "Email" {
+			t.Errorf("Errors[%d] = row %d column %q, want row %d column Email",
+				i, result.Errors[i].Row, result.Errors[i].Column, wantRow)
+		}
+	}
+}
+
+func TestWithProgress(t *testing.T) {
+	calls := 0
+	_, err := ReadFile("testdata/sample.xlsx", WithProgress(func(sheetName string, done, total int) {
+		calls++
+		if done != calls {
+			t.Errorf("done = %d, want %d", done, calls)
+		}
+	}))
+	if err != nil {
+		t.Fatalf("ReadFile with progress failed: %v", err)
+	}
+	if calls == 0 {
+		t.Error("Expected progress callback to be called")
+	}
+}
+
+func TestWithSheets(t *testing.T) {
+	full, err := ReadFile("testdata/sample.xlsx")
+	if err != nil {
+		t.Fatalf("ReadFile failed: %v", err)
+	}
+	first := full.Sheets[0].Name
+
+	workbook, err := ReadFile("testdata/sample.xlsx", WithSheets(first), WithParallel(true))
+	if err != nil {
+		t.Fatalf("ReadFile with sheets failed: %v", err)
+	}
+	if len(workbook.Sheets) != 1 || workbook.Sheets[0].Name != first {
+		t.Errorf("Expected only sheet %q, got %d sheets", first, len(workbook.Sheets))
+	}
+
+	_, err = ReadFile("testdata/sample.xlsx", WithSheets("DoesNotExist"))
+	if !errors.Is(err, ErrSheetNotFound) {
+		t.Errorf("Expected ErrSheetNotFound, got: %v", err)
+	}
+}
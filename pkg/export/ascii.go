@@ -0,0 +1,160 @@
+package export
+
+import (
+	"fmt"
+	"strings"
+	"time"
+	"unicode/utf8"
+
+	"github.com/meddhiazoghlami/goxls/pkg/models"
+)
+
+// ASCIITableOptions holds options for rendering a table as an ASCII grid
+type ASCIITableOptions struct {
+	Options
+
+	// MaxColumnWidth truncates longer values with "…" (0 = no limit)
+	MaxColumnWidth int
+
+	// MaxRows limits the number of data rows shown (0 = all)
+	MaxRows int
+
+	// DateFormat is the format for date values (empty uses Options.DateFormat,
+	// then "2006-01-02")
+	DateFormat string
+}
+
+// DefaultASCIITableOptions returns sensible defaults for ASCII tables
+func DefaultASCIITableOptions() ASCIITableOptions {
+	return ASCIITableOptions{
+		Options: DefaultOptions(),
+	}
+}
+
+// ToASCIITable renders a table as an aligned, bordered grid for terminals.
+// Column widths fit the content, numbers are right-aligned and line breaks
+// inside values are shown as spaces.
+//
+// Example output:
+//
+//	+-------+-----+
+//	| Name  | Age |
+//	+-------+-----+
+//	| Alice |  30 |
+//	+-------+-----+
+func ToASCIITable(table *models.Table, opts ASCIITableOptions) string {
+	table = opts.transformTable(table)
+	headers, _ := filterColumns(table, opts.selectedColumns())
+
+	rows := table.Rows
+	if opts.MaxRows > 0 && len(rows) > opts.MaxRows {
+		rows = rows[:opts.MaxRows]
+	}
+
+	// Render every value first so widths can be measured
+	names := opts.outputHeaders(headers)
+	widths := make([]int, len(headers))
+	for i, name := range names {
+		names[i] = opts.fit(name)
+		widths[i] = utf8.RuneCountInString(names[i])
+	}
+	cells := make([][]string, len(rows))
+	numeric := make([][]bool, len(rows))
+	for r, row := range rows {
+		cells[r] = make([]string, len(headers))
+		numeric[r] = make([]bool, len(headers))
+		for c, header := range headers {
+			cell, ok := row.Values[header]
+			text := opts.NullValue
+			if ok {
+				text = opts.formatCell(cell)
+				_, numeric[r][c] = cell.Value.(float64)
+			}
+			cells[r][c] = opts.fit(text)
+			if w := utf8.RuneCountInString(cells[r][c]); w > widths[c] {
+				widths[c] = w
+			}
+		}
+	}
+
+	var b strings.Builder
+	border := asciiBorder(widths)
+	b.WriteString(border)
+	if opts.IncludeHeaders {
+		writeASCIIRow(&b, names, widths, nil)
+		b.WriteString(border)
+	}
+	for r := range cells {
+		writeASCIIRow(&b, cells[r], widths, numeric[r])
+	}
+	if len(cells) > 0 {
+		b.WriteString(border)
+	}
+	return b.String()
+}
+
+// formatCell returns the display text for a cell
+func (o ASCIITableOptions) formatCell(cell models.Cell) string {
+	if cell.IsEmpty() {
+		return o.NullValue
+	}
+	switch v := cell.Value.(type) {
+	case time.Time:
+		return o.Options.formatDate(v, o.dateLayout(o.DateFormat, "2006-01-02"))
+	case float64:
+		if cell.RawValue != "" {
+			return cell.RawValue
+		}
+		return fmt.Sprintf("%g", v)
+	case bool:
+		if o.BoolFormat.isSet() {
+			return o.BoolFormat.format(v)
+		}
+		return fmt.Sprintf("%t", v)
+	case string:
+		return v
+	default:
+		return cell.RawValue
+	}
+}
+
+// fit flattens line breaks and truncates text to MaxColumnWidth
+func (o ASCIITableOptions) fit(text string) string {
+	text = strings.NewReplacer("\r\n", " ", "\n", " ", "\r", " ", "\t", " ").Replace(text)
+	if o.MaxColumnWidth <= 0 || utf8.RuneCountInString(text) <= o.MaxColumnWidth {
+		return text
+	}
+	if o.MaxColumnWidth == 1 {
+		return "…"
+	}
+	runes := []rune(text)
+	return string(runes[:o.MaxColumnWidth-1]) + "…"
+}
+
+// asciiBorder returns a +---+---+ separator line for the given column widths
+func asciiBorder(widths []int) string {
+	var b strings.Builder
+	b.WriteString("+")
+	for _, w := range widths {
+		b.WriteString(strings.Repeat("-", w+2))
+		b.WriteString("+")
+	}
+	b.WriteString("\n")
+	return b.String()
+}
+
+// writeASCIIRow writes one | a | b | line, right-aligning numeric values
+func writeASCIIRow(b *strings.Builder, values []string, widths []int, numeric []bool) {
+	b.WriteString("|")
+	for i, v := range values {
+		pad := strings.Repeat(" ", widths[i]-utf8.RuneCountInString(v))
+		b.WriteString(" ")
+		if numeric != nil && numeric[i] {
+			b.WriteString(pad + v)
+		} else {
+			b.WriteString(v + pad)
+		}
+		b.WriteString(" |")
+	}
+	b.WriteString("\n")
+}
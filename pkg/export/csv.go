@@ -5,11 +5,32 @@ import (
 	"encoding/csv"
 	"fmt"
 	"io"
+	"strings"
 	"time"
 
 	"github.com/meddhiazoghlami/goxls/pkg/models"
+	"golang.org/x/text/encoding/unicode"
+	"golang.org/x/text/transform"
 )
 
+// Encoding represents the character encoding of CSV output
+type Encoding int
+
+const (
+	EncodingUTF8 Encoding = iota
+	EncodingUTF16LE
+)
+
+// String returns the string representation of the encoding
+func (enc Encoding) String() string {
+	switch enc {
+	case EncodingUTF16LE:
+		return "utf-16le"
+	default:
+		return "utf-8"
+	}
+}
+
 // CSVOptions holds CSV-specific export options
 type CSVOptions struct {
 	Options
@@ -20,24 +41,47 @@ type CSVOptions struct {
 	// UseCRLF uses \r\n as line terminator (default: false, uses \n)
 	UseCRLF bool
 
-	// DateFormat is the format for date values (default: "2006-01-02")
+	// DateFormat is the format for date values (empty uses Options.DateFormat,
+	// then "2006-01-02")
 	DateFormat string
 
 	// QuoteAll forces quoting of all fields
 	QuoteAll bool
+
+	// Encoding is the output character encoding (default: EncodingUTF8)
+	Encoding Encoding
+
+	// WriteBOM prefixes the output with the byte order mark of the encoding
+	WriteBOM bool
+
+	// SanitizeFormulas prefixes text starting with =, +, -, @, tab or carriage
+	// return with a single quote so spreadsheet applications do not evaluate it
+	// as a formula. Numeric cells are left as they are.
+	SanitizeFormulas bool
 }
 
 // DefaultCSVOptions returns sensible defaults for CSV export
 func DefaultCSVOptions() *CSVOptions {
 	return &CSVOptions{
-		Options:    DefaultOptions(),
-		Delimiter:  ',',
-		UseCRLF:    false,
-		DateFormat: "2006-01-02",
-		QuoteAll:   false,
+		Options:   DefaultOptions(),
+		Delimiter: ',',
+		UseCRLF:   false,
+		QuoteAll:  false,
+		Encoding:  EncodingUTF8,
 	}
 }
 
+// ExcelSafeCSVOptions returns options for CSV files opened in Excel: a UTF-8
+// BOM so non-ASCII text is decoded correctly, CRLF line endings and formula
+// sanitizing to prevent CSV injection
+func ExcelSafeCSVOptions() *CSVOptions {
+	opts := DefaultCSVOptions()
+	opts.WriteBOM = true
+	opts.UseCRLF = true
+	opts.SanitizeFormulas = true
+	return opts
+}
+
 // CSVExporter exports tables to CSV format
 type CSVExporter struct {
 	opts *CSVOptions
@@ -53,7 +97,33 @@ func NewCSVExporter(opts *CSVOptions) *CSVExporter {
 
 // Export writes the table as CSV to the writer
 func (e *CSVExporter) Export(table *models.Table, w io.Writer) error {
-	headers, filter := filterColumns(table, e.opts.SelectedColumns)
+	switch e.opts.Encoding {
+	case EncodingUTF8:
+		if e.opts.WriteBOM {
+			if _, err := io.WriteString(w, "\uFEFF"); err != nil {
+				return fmt.Errorf("failed to write BOM: %w", err)
+			}
+		}
+		return e.export(table, w)
+	case EncodingUTF16LE:
+		bom := unicode.IgnoreBOM
+		if e.opts.WriteBOM {
+			bom = unicode.UseBOM
+		}
+		tw := transform.NewWriter(w, unicode.UTF16(unicode.LittleEndian, bom).NewEncoder())
+		if err := e.export(table, tw); err != nil {
+			return err
+		}
+		return tw.Close()
+	default:
+		return fmt.Errorf("unsupported encoding: %s", e.opts.Encoding)
+	}
+}
+
+// export writes the table as UTF-8 CSV to the writer
+func (e *CSVExporter) export(table *models.Table, w io.Writer) error {
+	table = e.opts.transformTable(table)
+	headers, filter := filterColumns(table, e.opts.selectedColumns())
 
 	csvWriter := csv.NewWriter(w)
 	csvWriter.Comma = e.opts.Delimiter
@@ -61,7 +131,15 @@ func (e *CSVExporter) Export(table *models.Table, w io.Writer) error {
 
 	// Write headers if enabled
 	if e.opts.IncludeHeaders {
-		if err := csvWriter.Write(headers); err != nil {
+		names := e.opts.outputHeaders(headers)
+		if e.opts.SanitizeFormulas {
+			sanitized := make([]string, len(names))
+			for i, name := range names {
+				sanitized[i] = sanitizeFormula(name)
+			}
+			names = sanitized
+		}
+		if err := csvWriter.Write(names); err != nil {
 			return fmt.Errorf("failed to write headers: %w", err)
 		}
 	}
@@ -73,7 +151,12 @@ func (e *CSVExporter) Export(table *models.Table, w io.Writer) error {
 			if filter[header] {
 				cell, ok := row.Values[header]
 				if ok {
-					record = append(record, e.formatCell(cell))
+					text := e.formatCell(cell)
+					// Numbers such as -5 are data, not formulas
+					if _, isNumber := cell.Value.(float64); e.opts.SanitizeFormulas && !isNumber {
+						text = sanitizeFormula(text)
+					}
+					record = append(record, text)
 				} else {
 					record = append(record, e.opts.NullValue)
 				}
@@ -96,7 +179,7 @@ func (e *CSVExporter) formatCell(cell models.Cell) string {
 
 	switch v := cell.Value.(type) {
 	case time.Time:
-		return v.Format(e.opts.DateFormat)
+		return e.opts.formatDate(v, e.opts.dateLayout(e.opts.DateFormat, "2006-01-02"))
 	case float64:
 		// Use RawValue to preserve original formatting if available
 		if cell.RawValue != "" {
@@ -104,6 +187,9 @@ func (e *CSVExporter) formatCell(cell models.Cell) string {
 		}
 		return fmt.Sprintf("%g", v)
 	case bool:
+		if e.opts.BoolFormat.isSet() {
+			return e.opts.BoolFormat.format(v)
+		}
 		if v {
 			return "true"
 		}
@@ -115,6 +201,18 @@ func (e *CSVExporter) formatCell(cell models.Cell) string {
 	}
 }
 
+// formulaPrefixes are the leading characters that can start a formula
+const formulaPrefixes = "=+-@\t\r"
+
+// sanitizeFormula prefixes a value that a spreadsheet would read as a formula
+// with a single quote, which makes it display as text
+func sanitizeFormula(s string) string {
+	if s != "" && strings.ContainsRune(formulaPrefixes, rune(s[0])) {
+		return "'" + s
+	}
+	return s
+}
+
 // ExportBytes returns the table as CSV bytes
 func (e *CSVExporter) ExportBytes(table *models.Table) ([]byte, error) {
 	buf := &bytes.Buffer{}
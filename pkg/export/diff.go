@@ -0,0 +1,203 @@
+package export
+
+import (
+	"encoding/json"
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/meddhiazoghlami/goxls/pkg/models"
+)
+
+// DiffToSQL turns a table diff into a SQL patch: DELETE statements for removed
+// rows, UPDATE statements for modified columns and INSERT statements for added
+// rows, in that order. Rows are matched on opts.KeyColumns, or on the diff's
+// KeyColumn when none are set. The tableName argument overrides opts.TableName.
+func DiffToSQL(diff models.DiffResult, tableName string, opts SQLOptions) (string, error) {
+	if tableName != "" {
+		opts.TableName = tableName
+	}
+	if opts.TableName == "" {
+		return "", fmt.Errorf("table name is required")
+	}
+	keys := opts.KeyColumns
+	if len(keys) == 0 && diff.KeyColumn != "" {
+		keys = []string{diff.KeyColumn}
+	}
+	if len(keys) == 0 {
+		return "", fmt.Errorf("DiffToSQL requires at least one key column")
+	}
+
+	e := NewSQLExporter(&opts)
+	table := e.escapeIdentifier(opts.TableName)
+	var statements []string
+
+	for _, row := range sortedByIndex(diff.RemovedRows) {
+		where, err := e.keyCondition(row, keys)
+		if err != nil {
+			return "", err
+		}
+		statements = append(statements, fmt.Sprintf("DELETE FROM %s WHERE %s;", table, where))
+	}
+
+	modified := make([]models.RowDiff, len(diff.ModifiedRows))
+	copy(modified, diff.ModifiedRows)
+	sort.SliceStable(modified, func(i, j int) bool { return modified[i].NewRow.Index < modified[j].NewRow.Index })
+	for _, rd := range modified {
+		if len(rd.Changes) == 0 {
+			continue
+		}
+		where, err := e.keyCondition(rd.OldRow, keys)
+		if err != nil {
+			return "", err
+		}
+		var sets []string
+		for _, change := range rd.Changes {
+			column := e.escapeIdentifier(opts.outputHeader(change.Column))
+			sets = append(sets, column+" = "+e.rowValue(rd.NewRow, change.Column))
+		}
+		statements = append(statements, fmt.Sprintf("UPDATE %s SET %s WHERE %s;", table, strings.Join(sets, ", "), where))
+	}
+
+	for _, row := range sortedByIndex(diff.AddedRows) {
+		columns := rowColumns(row, keys)
+		var names, values []string
+		for _, column := range columns {
+			names = append(names, e.escapeIdentifier(opts.outputHeader(column)))
+			values = append(values, e.rowValue(row, column))
+		}
+		statements = append(statements, fmt.Sprintf("INSERT INTO %s (%s) VALUES (%s);",
+			table, strings.Join(names, ", "), strings.Join(values, ", ")))
+	}
+
+	return strings.Join(statements, "\n"), nil
+}
+
+// keyCondition builds the WHERE clause matching a row on the key columns
+func (e *SQLExporter) keyCondition(row models.Row, keys []string) (string, error) {
+	var conditions []string
+	for _, key := range keys {
+		if _, ok := row.Values[key]; !ok {
+			return "", fmt.Errorf("key column not found: %s", key)
+		}
+		column := e.escapeIdentifier(e.opts.outputHeader(key))
+		value := e.rowValue(row, key)
+		if value == "NULL" {
+			conditions = append(conditions, column+" IS NULL")
+		} else {
+			conditions = append(conditions, column+" = "+value)
+		}
+	}
+	return strings.Join(conditions, " AND "), nil
+}
+
+// rowColumns returns a row's columns with the key columns first and the rest sorted
+func rowColumns(row models.Row, keys []string) []string {
+	isKey := make(map[string]bool, len(keys))
+	columns := make([]string, 0, len(row.Values))
+	for _, key := range keys {
+		if _, ok := row.Values[key]; ok {
+			columns = append(columns, key)
+		}
+		isKey[key] = true
+	}
+	rest := make([]string, 0, len(row.Values))
+	for column := range row.Values {
+		if !isKey[column] {
+			rest = append(rest, column)
+		}
+	}
+	sort.Strings(rest)
+	return append(columns, rest...)
+}
+
+// sortedByIndex returns a copy of rows ordered by their original row index
+func sortedByIndex(rows []models.Row) []models.Row {
+	sorted := make([]models.Row, len(rows))
+	copy(sorted, rows)
+	sort.SliceStable(sorted, func(i, j int) bool { return sorted[i].Index < sorted[j].Index })
+	return sorted
+}
+
+// jsonDiff is the JSON form of a DiffResult
+type jsonDiff struct {
+	KeyColumn string                   `json:"keyColumn"`
+	Added     []map[string]interface{} `json:"added"`
+	Removed   []map[string]interface{} `json:"removed"`
+	Modified  []jsonRowDiff            `json:"modified"`
+}
+
+// jsonRowDiff is the JSON form of a RowDiff
+type jsonRowDiff struct {
+	Key     string           `json:"key"`
+	Changes []jsonCellChange `json:"changes"`
+}
+
+// jsonCellChange is the JSON form of a CellDiff with typed before and after values
+type jsonCellChange struct {
+	Column string      `json:"column"`
+	Old    interface{} `json:"old"`
+	New    interface{} `json:"new"`
+}
+
+// DiffToJSON returns a table diff as a JSON document with "added" and
+// "removed" arrays of row objects and a "modified" array listing each changed
+// column with its old and new value
+func DiffToJSON(diff models.DiffResult, pretty bool) (string, error) {
+	out := jsonDiff{
+		KeyColumn: diff.KeyColumn,
+		Added:     make([]map[string]interface{}, 0, len(diff.AddedRows)),
+		Removed:   make([]map[string]interface{}, 0, len(diff.RemovedRows)),
+		Modified:  make([]jsonRowDiff, 0, len(diff.ModifiedRows)),
+	}
+	for _, row := range sortedByIndex(diff.AddedRows) {
+		out.Added = append(out.Added, rowObject(row))
+	}
+	for _, row := range sortedByIndex(diff.RemovedRows) {
+		out.Removed = append(out.Removed, rowObject(row))
+	}
+
+	modified := make([]models.RowDiff, len(diff.ModifiedRows))
+	copy(modified, diff.ModifiedRows)
+	sort.SliceStable(modified, func(i, j int) bool { return modified[i].NewRow.Index < modified[j].NewRow.Index })
+	for _, rd := range modified {
+		entry := jsonRowDiff{Key: rd.KeyValue, Changes: make([]jsonCellChange, 0, len(rd.Changes))}
+		for _, change := range rd.Changes {
+			entry.Changes = append(entry.Changes, jsonCellChange{
+				Column: change.Column,
+				Old:    rowJSONValue(rd.OldRow, change.Column),
+				New:    rowJSONValue(rd.NewRow, change.Column),
+			})
+		}
+		out.Modified = append(out.Modified, entry)
+	}
+
+	var data []byte
+	var err error
+	if pretty {
+		data, err = json.MarshalIndent(out, "", "  ")
+	} else {
+		data, err = json.Marshal(out)
+	}
+	if err != nil {
+		return "", err
+	}
+	return string(data), nil
+}
+
+// rowObject returns a row's values as a JSON object
+func rowObject(row models.Row) map[string]interface{} {
+	obj := make(map[string]interface{}, len(row.Values))
+	for column, cell := range row.Values {
+		obj[column] = getCellValue(cell, "")
+	}
+	return obj
+}
+
+// rowJSONValue returns a row's JSON value for a column, or nil if it is missing
+func rowJSONValue(row models.Row, column string) interface{} {
+	if cell, ok := row.Values[column]; ok {
+		return getCellValue(cell, "")
+	}
+	return nil
+}
@@ -28,6 +28,45 @@
 //	exporter := export.NewJSONExporter(opts)
 //	result, err := exporter.ExportString(table)
 //
+// MetadataFields picks the fields of the wrapping object and ExtraMetadata
+// adds custom ones; ArrayOnly drops the wrapping object entirely:
+//
+//	opts.MetadataFields = []string{"rows", "count"}
+//	opts.ExtraMetadata = map[string]interface{}{"generatedAt": time.Now()}
+//
+// HeaderAliases renames columns in the output without renaming the table.
+// SelectedColumns still refers to the original names:
+//
+//	opts.HeaderAliases = map[string]string{"Name": "full_name"}
+//
+// BoolFormat sets the literals written for booleans in every format. JSON
+// then emits them as strings; SQL uses a text column except on PostgreSQL,
+// which keeps its native BOOLEAN:
+//
+//	opts.BoolFormat = export.BoolFormat{True: "Y", False: "N"}
+//
+// A literal left empty is written as "true" or "false".
+//
+// RowTransform rewrites each row before it is written. Columns it adds are
+// exported after the table's own columns:
+//
+//	opts.RowTransform = func(row models.Row) models.Row {
+//	    row.Values["BatchID"] = models.Cell{Type: models.CellTypeString, Value: "b42", RawValue: "b42"}
+//	    return row
+//	}
+//
+// Location converts dates to another timezone before they are formatted, and
+// DateFormat sets the layout for exporters without their own, such as JSON:
+//
+//	opts.Location, _ = time.LoadLocation("America/New_York")
+//	opts.DateFormat = "2006-01-02 15:04"
+//
+// IncludeRowNumber adds a leading column with each row's row number in the
+// source sheet, named by RowNumberHeader (default "Row"):
+//
+//	opts.IncludeRowNumber = true
+//	opts.RowNumberHeader = "SourceRow"
+//
 // # CSV Export
 //
 // Export with custom delimiter:
@@ -39,6 +78,14 @@
 //	exporter := export.NewCSVExporter(opts)
 //	result, err := exporter.ExportString(table)
 //
+// Set Encoding to EncodingUTF16LE for tools that require UTF-16 input,
+// and WriteBOM to prefix the output with a byte order mark.
+//
+// ExcelSafeCSVOptions combines a BOM, CRLF line endings and SanitizeFormulas,
+// which quotes text such as =1+1 so Excel shows it instead of evaluating it:
+//
+//	result, err := export.NewCSVExporter(export.ExcelSafeCSVOptions()).ExportString(table)
+//
 // # SQL Export
 //
 // Export with dialect support:
@@ -52,6 +99,37 @@
 //	exporter := export.NewSQLExporter(opts)
 //	result, err := exporter.ExportString(table)
 //
+// For PostgreSQL bulk loads, CopyMode emits a COPY ... FROM STDIN block with
+// tab-separated rows and \N for nulls instead of INSERT statements:
+//
+//	opts.CopyMode = true
+//
+// IdentifierCase folds table and column names before quoting, e.g. CaseLower
+// turns a "FirstName" header into "firstname" to match PostgreSQL conventions.
+//
+// For idempotent loads, InsertIfNotExists emits one INSERT ... SELECT per row
+// that is skipped when a row with the same KeyColumns already exists:
+//
+//	opts.InsertIfNotExists = true
+//	opts.KeyColumns = []string{"ID"}
+//
+// DiffToSQL turns the result of models.DiffTables into a patch of DELETE,
+// UPDATE and INSERT statements keyed on the diff's key column:
+//
+//	diff := models.DiffTables(oldTable, newTable, "ID")
+//	patch, err := export.DiffToSQL(diff, "users", *export.DefaultSQLOptions())
+//
+// DiffToJSON returns the same diff as a JSON document for API-driven sync,
+// with added and removed row objects and old/new values for each modified column.
+//
+// LoadToDB executes a prepared INSERT against a *sql.DB instead of generating
+// text, committing BatchSize rows per transaction:
+//
+//	opts := export.DefaultSQLLoadOptions()
+//	opts.TableName = "users"
+//	opts.BatchSize = 500
+//	inserted, err := export.LoadToDB(ctx, table, db, opts)
+//
 // # SQL Dialects
 //
 // Supported SQL dialects:
@@ -61,6 +139,11 @@
 //   - DialectPostgreSQL: PostgreSQL-specific syntax
 //   - DialectSQLite: SQLite-specific syntax
 //
+// SQLite stores numbers written as text in the sheet as text. With
+// SQLiteStrictTypes, values of numeric and boolean columns are wrapped in
+// CAST(... AS REAL) or CAST(... AS INTEGER); columns holding only whole
+// numbers use INTEGER.
+//
 // # Writing to Files or Streams
 //
 // All exporters implement the Exporter interface:
@@ -70,4 +153,57 @@
 //	    ExportBytes(table *models.Table) ([]byte, error)
 //	    ExportString(table *models.Table) (string, error)
 //	}
+//
+// ExportGzip compresses the output as it is written, for large downloads:
+//
+//	w.Header().Set("Content-Encoding", "gzip")
+//	err := export.ExportGzip(table, export.FormatCSV, nil, w)
+//
+// # Custom Formats
+//
+// RegisterFormat plugs an exporter in under a new name. The returned Format
+// works with NewExporter, Export and ExportString, and ParseFormat (and thus
+// the CLI --format flag) accepts the name:
+//
+//	columnar, err := export.RegisterFormat("columnar", func(opts interface{}) export.Exporter {
+//	    return NewColumnarExporter()
+//	})
+//	out, err := export.ExportString(table, columnar)
+//
+// # ASCII Tables
+//
+// ToASCIITable renders a table as a bordered grid for terminal output:
+//
+//	opts := export.DefaultASCIITableOptions()
+//	opts.MaxColumnWidth = 30 // longer values end in "…"
+//	fmt.Print(export.ToASCIITable(table, opts))
+//
+// # Zip Bundles
+//
+// ToZip writes one archive with an entry per format, named after the table:
+//
+//	f, _ := os.Create("handoff.zip")
+//	defer f.Close()
+//	err := export.ToZip(table, []export.Format{export.FormatCSV, export.FormatJSON, export.FormatSQL}, f)
+//
+// ExportEach writes many tables to one file each, e.g. "out/Sales.json",
+// using a bounded pool of workers:
+//
+//	err := export.ExportEach(tables, "out", export.FormatJSON, nil, 4)
+//
+// # Streaming XLSX
+//
+// XLSXStreamWriter writes an xlsx sheet row by row without holding the rows
+// in memory. The workbook is written out by Close:
+//
+//	sw, err := export.NewXLSXStreamWriter(f, "Data", table.Headers)
+//	for _, row := range table.Rows {
+//	    err = sw.AddRow(row.Cells)
+//	}
+//	err = sw.Close()
+//
+// WorkbookToXLSX writes a whole workbook back out, one sheet per source
+// sheet or, with SheetPerTable, one per table:
+//
+//	err := export.WorkbookToXLSX(workbook, f, export.DefaultXLSXOptions())
 package export
@@ -0,0 +1,85 @@
+package export
+
+import (
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"runtime"
+	"sync"
+
+	"github.com/meddhiazoghlami/goxls/pkg/models"
+)
+
+// ExportEach writes each table to its own file in dir, named after the table
+// like ToZip entries, e.g. "Sales.csv". Files are written by up to concurrency
+// workers (0 or less uses GOMAXPROCS). opts is passed to NewExporter; pass nil
+// to use defaults. dir is created if needed. Every table is attempted and the
+// failures are returned together.
+func ExportEach(tables []*models.Table, dir string, format Format, opts interface{}, concurrency int) error {
+	// Fail on bad options before creating any files
+	if _, err := NewExporter(format, opts); err != nil {
+		return err
+	}
+
+	paths := make([]string, len(tables))
+	seen := make(map[string]bool, len(tables))
+	for i, table := range tables {
+		if table == nil {
+			return fmt.Errorf("table %d is nil", i)
+		}
+		name := zipEntryBase(table) + "." + format.String()
+		if seen[name] {
+			return fmt.Errorf("duplicate output file: %s", name)
+		}
+		seen[name] = true
+		paths[i] = filepath.Join(dir, name)
+	}
+
+	if err := os.MkdirAll(dir, 0755); err != nil {
+		return err
+	}
+
+	if concurrency <= 0 {
+		concurrency = runtime.GOMAXPROCS(0)
+	}
+
+	jobs := make(chan int)
+	errs := make([]error, len(tables))
+	var wg sync.WaitGroup
+	for w := 0; w < concurrency && w < len(tables); w++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for i := range jobs {
+				if err := exportFile(tables[i], paths[i], format, opts); err != nil {
+					errs[i] = fmt.Errorf("%s: %w", tables[i].Name, err)
+				}
+			}
+		}()
+	}
+	for i := range tables {
+		jobs <- i
+	}
+	close(jobs)
+	wg.Wait()
+
+	return errors.Join(errs...)
+}
+
+// exportFile writes a table to path with a fresh exporter
+func exportFile(table *models.Table, path string, format Format, opts interface{}) error {
+	exporter, err := NewExporter(format, opts)
+	if err != nil {
+		return err
+	}
+	f, err := os.Create(path)
+	if err != nil {
+		return err
+	}
+	if err := exporter.Export(table, f); err != nil {
+		f.Close()
+		return err
+	}
+	return f.Close()
+}
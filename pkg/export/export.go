@@ -1,8 +1,12 @@
 package export
 
 import (
+	"compress/gzip"
 	"fmt"
 	"io"
+	"sort"
+	"strconv"
+	"time"
 
 	"github.com/meddhiazoghlami/goxls/pkg/models"
 )
@@ -26,12 +30,26 @@ func (f Format) String() string {
 	case FormatSQL:
 		return "sql"
 	default:
+		if rf, ok := lookupFormat(f); ok {
+			return rf.name
+		}
 		return "unknown"
 	}
 }
 
-// ParseFormat parses a string into a Format
+// ParseFormat parses a string into a Format, including formats added with RegisterFormat
 func ParseFormat(s string) (Format, error) {
+	if format, err := parseBuiltinFormat(s); err == nil {
+		return format, nil
+	}
+	if format, ok := lookupFormatName(s); ok {
+		return format, nil
+	}
+	return 0, fmt.Errorf("unknown format: %s", s)
+}
+
+// parseBuiltinFormat parses the name of a built-in format
+func parseBuiltinFormat(s string) (Format, error) {
 	switch s {
 	case "json", "JSON":
 		return FormatJSON, nil
@@ -66,6 +84,226 @@ type Options struct {
 
 	// SelectedColumns limits export to specific columns (empty means all)
 	SelectedColumns []string
+
+	// HeaderAliases maps original header names to the names written in the output.
+	// Columns are still selected and read by their original names.
+	HeaderAliases map[string]string
+
+	// BoolFormat sets the literals written for boolean cells, e.g. Y/N or 1/0
+	// (zero value keeps each exporter's default)
+	BoolFormat BoolFormat
+
+	// RowTransform is applied to every row before it is written, e.g. to add a
+	// batch id column or redact a field. Columns it adds are exported after the
+	// table's own columns.
+	RowTransform func(models.Row) models.Row
+
+	// DateFormat is the layout for date values in exporters without their own
+	// DateFormat, such as JSON (empty keeps the exporter's default, RFC 3339 for JSON).
+	// An exporter's own DateFormat takes precedence when set.
+	DateFormat string
+
+	// Location converts dates to this timezone before formatting, e.g. to
+	// render UTC timestamps in America/New_York (nil keeps dates as read)
+	Location *time.Location
+
+	// IncludeRowNumber adds a leading column holding each row's 1-based row
+	// number in the source sheet, for tracing output back to the spreadsheet
+	IncludeRowNumber bool
+
+	// RowNumberHeader names the row number column (default "Row"). A table
+	// column of the same name is replaced.
+	RowNumberHeader string
+}
+
+// formatDate converts t to Location and formats it with layout, falling back
+// to DateFormat and then RFC 3339 when layout is empty
+func (o Options) formatDate(t time.Time, layout string) string {
+	if layout == "" {
+		layout = o.DateFormat
+	}
+	if layout == "" {
+		layout = time.RFC3339
+	}
+	return o.inLocation(t).Format(layout)
+}
+
+// dateLayout returns layout, or DateFormat when layout is empty, or fallback
+// when neither is set
+func (o Options) dateLayout(layout, fallback string) string {
+	if layout != "" {
+		return layout
+	}
+	if o.DateFormat != "" {
+		return o.DateFormat
+	}
+	return fallback
+}
+
+// inLocation returns t converted to Location, or t itself when Location is nil
+func (o Options) inLocation(t time.Time) time.Time {
+	if o.Location == nil {
+		return t
+	}
+	return t.In(o.Location)
+}
+
+// transformTable returns the table with RowTransform applied to each row and
+// the row number column added, or the table itself when neither is set
+func (o Options) transformTable(table *models.Table) *models.Table {
+	if o.IncludeRowNumber {
+		table = o.addRowNumbers(table)
+	}
+	if o.RowTransform == nil {
+		return table
+	}
+
+	known := make(map[string]bool, len(table.Headers))
+	for _, h := range table.Headers {
+		known[h] = true
+	}
+
+	result := *table
+	result.Rows = make([]models.Row, len(table.Rows))
+	var added []string
+	for i, row := range table.Rows {
+		// Give the transform its own map so the source table is untouched
+		values := make(map[string]models.Cell, len(row.Values))
+		for k, v := range row.Values {
+			values[k] = v
+		}
+		row.Values = values
+
+		row = o.RowTransform(row)
+		result.Rows[i] = row
+
+		var newCols []string
+		for header := range row.Values {
+			if !known[header] {
+				known[header] = true
+				newCols = append(newCols, header)
+			}
+		}
+		sort.Strings(newCols)
+		added = append(added, newCols...)
+	}
+
+	if len(added) > 0 {
+		result.Headers = append(append([]string{}, table.Headers...), added...)
+	}
+	return &result
+}
+
+// rowNumberHeader returns the name of the row number column
+func (o Options) rowNumberHeader() string {
+	if o.RowNumberHeader != "" {
+		return o.RowNumberHeader
+	}
+	return "Row"
+}
+
+// selectedColumns returns SelectedColumns with the row number column first
+// when both are set
+func (o Options) selectedColumns() []string {
+	if !o.IncludeRowNumber || len(o.SelectedColumns) == 0 {
+		return o.SelectedColumns
+	}
+	return append([]string{o.rowNumberHeader()}, o.SelectedColumns...)
+}
+
+// addRowNumbers returns a copy of the table with the row number column first.
+// Rows read from a sheet take the sheet row of their cells, which stays right
+// when hidden rows are skipped; rows built by hand fall back to their Index and
+// then to their position below the header row.
+func (o Options) addRowNumbers(table *models.Table) *models.Table {
+	name := o.rowNumberHeader()
+
+	result := *table
+	result.Headers = []string{name}
+	for _, h := range table.Headers {
+		if h != name {
+			result.Headers = append(result.Headers, h)
+		}
+	}
+	result.Rows = make([]models.Row, len(table.Rows))
+	for i, row := range table.Rows {
+		sheetRow := cellsRow(row, table.HeaderRow)
+		if sheetRow <= table.HeaderRow {
+			sheetRow = row.Index
+		}
+		if sheetRow <= table.HeaderRow {
+			sheetRow = table.HeaderRow + 1 + i
+		}
+		number := float64(sheetRow + 1)
+
+		values := make(map[string]models.Cell, len(row.Values)+1)
+		for k, v := range row.Values {
+			values[k] = v
+		}
+		values[name] = models.Cell{Value: number, Type: models.CellTypeNumber, RawValue: strconv.Itoa(sheetRow + 1), Row: sheetRow}
+		row.Values = values
+		result.Rows[i] = row
+	}
+	return &result
+}
+
+// cellsRow returns the 0-based sheet row of the first non-empty cell in a
+// row's own Cells that lies below headerRow, or -1 when there is none. Values
+// is not used: an enriched or merged column can hold another row's cell.
+func cellsRow(row models.Row, headerRow int) int {
+	for _, cell := range row.Cells {
+		if !cell.IsEmpty() && cell.Row > headerRow {
+			return cell.Row
+		}
+	}
+	return -1
+}
+
+// BoolFormat holds the literals used for true and false values. A literal
+// left empty while the other is set is written as "true" or "false", so a
+// false value never looks like a null.
+type BoolFormat struct {
+	True  string
+	False string
+}
+
+// isSet reports whether custom literals were configured
+func (b BoolFormat) isSet() bool {
+	return b.True != "" || b.False != ""
+}
+
+// format returns the literal for a boolean value
+func (b BoolFormat) format(v bool) string {
+	switch {
+	case v && b.True != "":
+		return b.True
+	case v:
+		return "true"
+	case b.False != "":
+		return b.False
+	default:
+		return "false"
+	}
+}
+
+// outputHeader returns the name to write for a header, applying any alias
+func (o Options) outputHeader(header string) string {
+	if alias, ok := o.HeaderAliases[header]; ok {
+		return alias
+	}
+	return header
+}
+
+// outputHeaders returns the names to write for a list of headers
+func (o Options) outputHeaders(headers []string) []string {
+	if len(o.HeaderAliases) == 0 {
+		return headers
+	}
+	names := make([]string, len(headers))
+	for i, h := range headers {
+		names[i] = o.outputHeader(h)
+	}
+	return names
 }
 
 // DefaultOptions returns sensible default options
@@ -95,6 +333,21 @@ func ExportString(table *models.Table, format Format) (string, error) {
 	return exporter.ExportString(table)
 }
 
+// ExportGzip exports a table to w as a gzip-compressed stream.
+// opts is passed to NewExporter; pass nil to use defaults.
+func ExportGzip(table *models.Table, format Format, opts interface{}, w io.Writer) error {
+	exporter, err := NewExporter(format, opts)
+	if err != nil {
+		return err
+	}
+	gz := gzip.NewWriter(w)
+	if err := exporter.Export(table, gz); err != nil {
+		gz.Close()
+		return err
+	}
+	return gz.Close()
+}
+
 // NewExporter creates an exporter for the given format
 // Pass nil for opts to use defaults
 func NewExporter(format Format, opts interface{}) (Exporter, error) {
@@ -127,6 +380,12 @@ func NewExporter(format Format, opts interface{}) (Exporter, error) {
 		return nil, fmt.Errorf("invalid options type for SQL exporter")
 
 	default:
+		if rf, ok := lookupFormat(format); ok {
+			if exporter := rf.factory(opts); exporter != nil {
+				return exporter, nil
+			}
+			return nil, fmt.Errorf("factory for format %s returned no exporter", rf.name)
+		}
 		return nil, fmt.Errorf("unsupported format: %v", format)
 	}
 }
@@ -167,5 +426,5 @@ func getCellValue(cell models.Cell, nullValue string) interface{} {
 		}
 		return nil
 	}
-	return cell.Value
+	return cell.AsJSON()
 }
@@ -1,14 +1,23 @@
 package export
 
 import (
+	"archive/zip"
 	"bytes"
+	"compress/gzip"
+	"context"
 	"encoding/json"
 	"fmt"
+	"io"
+	"os"
+	"path/filepath"
 	"strings"
 	"testing"
 	"time"
 
 	"github.com/meddhiazoghlami/goxls/pkg/models"
+	"github.com/meddhiazoghlami/goxls/pkg/reader"
+	"github.com/xuri/excelize/v2"
+	"golang.org/x/text/encoding/unicode"
 )
 
 // Helper function to create a test table
@@ -107,6 +116,83 @@ func TestParseFormat(t *testing.T) {
 	}
 }
 
+// columnarExporter writes each column on its own line for registry tests
+type columnarExporter struct {
+	sep string
+}
+
+func (e *columnarExporter) Export(table *models.Table, w io.Writer) error {
+	for _, h := range table.Headers {
+		var values []string
+		for _, row := range table.Rows {
+			cell, _ := row.Get(h)
+			values = append(values, cell.AsString())
+		}
+		if _, err := fmt.Fprintf(w, "%s: %s\n", h, strings.Join(values, e.sep)); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (e *columnarExporter) ExportBytes(table *models.Table) ([]byte, error) {
+	var buf bytes.Buffer
+	err := e.Export(table, &buf)
+	return buf.Bytes(), err
+}
+
+func (e *columnarExporter) ExportString(table *models.Table) (string, error) {
+	b, err := e.ExportBytes(table)
+	return string(b), err
+}
+
+func TestRegisterFormat(t *testing.T) {
+	format, err := RegisterFormat("columnar", func(opts interface{}) Exporter {
+		if sep, ok := opts.(string); ok {
+			return &columnarExporter{sep: sep}
+		}
+		return &columnarExporter{sep: ","}
+	})
+	if err != nil {
+		t.Fatalf("RegisterFormat() error = %v", err)
+	}
+
+	if format.String() != "columnar" {
+		t.Errorf("String() = %q, want %q", format.String(), "columnar")
+	}
+	parsed, err := ParseFormat("Columnar")
+	if err != nil || parsed != format {
+		t.Errorf("ParseFormat(%q) = %v, %v, want %v", "Columnar", parsed, err, format)
+	}
+
+	table := createTestTable()
+	result, err := ExportString(table, format)
+	if err != nil {
+		t.Fatalf("ExportString() error = %v", err)
+	}
+	if !strings.Contains(result, "Name: Alice,Bob,Charlie\n") {
+		t.Errorf("ExportString() = %q, want Name column on one line", result)
+	}
+
+	exporter, err := NewExporter(format, ";")
+	if err != nil {
+		t.Fatalf("NewExporter() error = %v", err)
+	}
+	result, _ = exporter.ExportString(table)
+	if !strings.Contains(result, "Name: Alice;Bob;Charlie\n") {
+		t.Errorf("ExportString() with opts = %q, want ; separator", result)
+	}
+
+	for _, name := range []string{"columnar", "CSV", ""} {
+		if _, err := RegisterFormat(name, func(interface{}) Exporter { return nil }); err == nil {
+			t.Errorf("RegisterFormat(%q) expected error", name)
+		}
+	}
+	if _, err := RegisterFormat("nilfactory", nil); err == nil {
+		t.Error("RegisterFormat() with nil factory expected error")
+	}
+}
+
 func TestNewExporter(t *testing.T) {
 	tests := []struct {
 		name    string
@@ -165,6 +251,40 @@ func TestExportStringConvenienceFunction(t *testing.T) {
 
 // ============ JSON Tests ============
 
+func TestExportGzip(t *testing.T) {
+	table := createTestTable()
+
+	for _, format := range []Format{FormatCSV, FormatJSON, FormatSQL} {
+		t.Run(format.String(), func(t *testing.T) {
+			var plain bytes.Buffer
+			if err := Export(table, format, &plain); err != nil {
+				t.Fatalf("Export() error = %v", err)
+			}
+
+			var compressed bytes.Buffer
+			if err := ExportGzip(table, format, nil, &compressed); err != nil {
+				t.Fatalf("ExportGzip() error = %v", err)
+			}
+
+			gz, err := gzip.NewReader(&compressed)
+			if err != nil {
+				t.Fatalf("gzip.NewReader() error = %v", err)
+			}
+			got, err := io.ReadAll(gz)
+			if err != nil {
+				t.Fatalf("reading gzip stream: %v", err)
+			}
+			if !bytes.Equal(got, plain.Bytes()) {
+				t.Errorf("decompressed output = %q, want %q", got, plain.String())
+			}
+		})
+	}
+
+	if err := ExportGzip(table, Format(999), nil, io.Discard); err == nil {
+		t.Error("ExportGzip() with unknown format should return an error")
+	}
+}
+
 func TestJSONExporter(t *testing.T) {
 	table := createTestTable()
 	exporter := NewJSONExporter(nil)
@@ -227,6 +347,43 @@ func TestJSONExporterArrayOnly(t *testing.T) {
 	}
 }
 
+func TestJSONExporterMetadata(t *testing.T) {
+	table := createTestTable()
+	opts := DefaultJSONOptions()
+	opts.MetadataFields = []string{"rows", "count"}
+	opts.ExtraMetadata = map[string]interface{}{"generatedAt": "2024-05-01T12:00:00Z"}
+
+	result, err := NewJSONExporter(opts).ExportString(table)
+	if err != nil {
+		t.Fatalf("ExportString() error = %v", err)
+	}
+
+	var data map[string]interface{}
+	if err := json.Unmarshal([]byte(result), &data); err != nil {
+		t.Fatalf("Unmarshal() error = %v", err)
+	}
+	if len(data) != 3 {
+		t.Errorf("keys = %v, want rows, count and generatedAt", data)
+	}
+	if rows, ok := data["rows"].([]interface{}); !ok || len(rows) != 3 {
+		t.Errorf("rows = %v, want 3 rows", data["rows"])
+	}
+	if data["count"] != float64(3) || data["generatedAt"] != "2024-05-01T12:00:00Z" {
+		t.Errorf("count = %v, generatedAt = %v, want 3 and 2024-05-01T12:00:00Z", data["count"], data["generatedAt"])
+	}
+
+	opts.MetadataFields = []string{"rows", "size"}
+	if _, err := NewJSONExporter(opts).ExportString(table); err == nil {
+		t.Error("unknown metadata field: error = nil, want an error")
+	}
+
+	opts.MetadataFields = nil
+	opts.ExtraMetadata = map[string]interface{}{"name": "other"}
+	if _, err := NewJSONExporter(opts).ExportString(table); err == nil {
+		t.Error("clashing extra metadata: error = nil, want an error")
+	}
+}
+
 func TestJSONExporterSelectedColumns(t *testing.T) {
 	table := createTestTable()
 	opts := DefaultJSONOptions()
@@ -246,6 +403,56 @@ func TestJSONExporterSelectedColumns(t *testing.T) {
 	}
 }
 
+func TestJSONExporterHeaderAliases(t *testing.T) {
+	table := createTestTable()
+	opts := DefaultJSONOptions()
+	opts.SelectedColumns = []string{"Name", "Age"}
+	opts.HeaderAliases = map[string]string{"Name": "full_name"}
+
+	result, err := NewJSONExporter(opts).ExportString(table)
+	if err != nil {
+		t.Fatalf("ExportString() error = %v", err)
+	}
+
+	var data map[string]interface{}
+	if err := json.Unmarshal([]byte(result), &data); err != nil {
+		t.Fatalf("json.Unmarshal() error = %v", err)
+	}
+
+	headers := data["headers"].([]interface{})
+	if len(headers) != 2 || headers[0] != "full_name" || headers[1] != "Age" {
+		t.Errorf("headers = %v, want [full_name Age]", headers)
+	}
+
+	row := data["rows"].([]interface{})[0].(map[string]interface{})
+	if row["full_name"] != "Alice" {
+		t.Errorf("row[full_name] = %v, want Alice", row["full_name"])
+	}
+	if _, ok := row["Name"]; ok {
+		t.Error("Original header should not appear as a key")
+	}
+}
+
+func TestJSONExporterBoolFormat(t *testing.T) {
+	table := createTestTable()
+	opts := DefaultJSONOptions()
+	opts.ArrayOnly = true
+	opts.BoolFormat = BoolFormat{True: "Y", False: "N"}
+
+	result, err := NewJSONExporter(opts).ExportString(table)
+	if err != nil {
+		t.Fatalf("ExportString() error = %v", err)
+	}
+
+	var rows []map[string]interface{}
+	if err := json.Unmarshal([]byte(result), &rows); err != nil {
+		t.Fatalf("json.Unmarshal() error = %v", err)
+	}
+	if rows[0]["Active"] != "Y" || rows[1]["Active"] != "N" {
+		t.Errorf("Active = %v, %v, want Y, N", rows[0]["Active"], rows[1]["Active"])
+	}
+}
+
 func TestJSONConvenienceFunctions(t *testing.T) {
 	table := createTestTable()
 
@@ -279,6 +486,38 @@ func TestJSONConvenienceFunctions(t *testing.T) {
 
 // ============ CSV Tests ============
 
+func TestExportBuiltTable(t *testing.T) {
+	table := models.NewTableBuilder("People").
+		Headers("Name", "Age", "Active").
+		AddRow("Alice", 30, true).
+		AddRow("Bob", nil, false).
+		Build()
+
+	csv, err := ToCSV(table)
+	if err != nil {
+		t.Fatalf("ToCSV() error = %v", err)
+	}
+	if want := "Name,Age,Active\nAlice,30,true\nBob,,false\n"; csv != want {
+		t.Errorf("ToCSV() = %q, want %q", csv, want)
+	}
+
+	jsonStr, err := ToJSON(table)
+	if err != nil {
+		t.Fatalf("ToJSON() error = %v", err)
+	}
+	var data struct {
+		Name string                   `json:"name"`
+		Rows []map[string]interface{} `json:"rows"`
+	}
+	if err := json.Unmarshal([]byte(jsonStr), &data); err != nil {
+		t.Fatalf("json.Unmarshal() error = %v", err)
+	}
+	records := data.Rows
+	if data.Name != "People" || len(records) != 2 || records[0]["Age"] != float64(30) || records[1]["Age"] != nil || records[1]["Active"] != false {
+		t.Errorf("ToJSON() = %s, want typed values with a null Age for Bob", jsonStr)
+	}
+}
+
 func TestCSVExporter(t *testing.T) {
 	table := createTestTable()
 	exporter := NewCSVExporter(nil)
@@ -345,6 +584,438 @@ func TestCSVExporterDateFormat(t *testing.T) {
 	}
 }
 
+func TestSharedDateFormat(t *testing.T) {
+	table := createTestTable()
+
+	csvOpts := DefaultCSVOptions()
+	result, err := NewCSVExporter(csvOpts).ExportString(table)
+	if err != nil {
+		t.Fatalf("ExportString() error = %v", err)
+	}
+	if !strings.Contains(result, "2023-01-15") {
+		t.Errorf("CSV default date should be 2006-01-02, got: %s", result)
+	}
+
+	csvOpts.Options.DateFormat = "02/01/2006"
+	result, err = NewCSVExporter(csvOpts).ExportString(table)
+	if err != nil {
+		t.Fatalf("ExportString() error = %v", err)
+	}
+	if !strings.Contains(result, "15/01/2023") {
+		t.Errorf("CSV should use Options.DateFormat, got: %s", result)
+	}
+
+	asciiOpts := DefaultASCIITableOptions()
+	if out := ToASCIITable(table, asciiOpts); !strings.Contains(out, "2023-01-15") {
+		t.Errorf("ASCII default date should be 2006-01-02, got:\n%s", out)
+	}
+	asciiOpts.Options.DateFormat = "02/01/2006"
+	if out := ToASCIITable(table, asciiOpts); !strings.Contains(out, "15/01/2023") {
+		t.Errorf("ASCII should use Options.DateFormat, got:\n%s", out)
+	}
+
+	sqlOpts := DefaultSQLOptions()
+	result, err = NewSQLExporter(sqlOpts).ExportString(table)
+	if err != nil {
+		t.Fatalf("SQL ExportString() error = %v", err)
+	}
+	if !strings.Contains(result, "'2023-01-15 00:00:00'") {
+		t.Errorf("SQL default date should be 2006-01-02 15:04:05, got: %s", result)
+	}
+	sqlOpts.Options.DateFormat = "02/01/2006"
+	result, err = NewSQLExporter(sqlOpts).ExportString(table)
+	if err != nil {
+		t.Fatalf("SQL ExportString() error = %v", err)
+	}
+	if !strings.Contains(result, "'15/01/2023'") {
+		t.Errorf("SQL should use Options.DateFormat, got: %s", result)
+	}
+}
+
+func TestExportDateLocation(t *testing.T) {
+	newYork, err := time.LoadLocation("America/New_York")
+	if err != nil {
+		t.Skipf("timezone data unavailable: %v", err)
+	}
+	table := models.NewTableBuilder("Events").
+		Headers("At").
+		AddRow(time.Date(2023, 1, 15, 3, 30, 0, 0, time.UTC)).
+		Build()
+
+	csvOpts := DefaultCSVOptions()
+	csvOpts.Location = newYork
+	csvOpts.DateFormat = "2006-01-02 15:04"
+	csvResult, err := NewCSVExporter(csvOpts).ExportString(table)
+	if err != nil {
+		t.Fatalf("CSV ExportString() error = %v", err)
+	}
+	if want := "At\n2023-01-14 22:30\n"; csvResult != want {
+		t.Errorf("CSV = %q, want %q", csvResult, want)
+	}
+
+	sqlOpts := DefaultSQLOptions()
+	sqlOpts.TableName = "events"
+	sqlOpts.Location = newYork
+	sqlResult, err := NewSQLExporter(sqlOpts).ExportString(table)
+	if err != nil {
+		t.Fatalf("SQL ExportString() error = %v", err)
+	}
+	if !strings.Contains(sqlResult, "'2023-01-14 22:30:00'") {
+		t.Errorf("SQL should contain the shifted date, got: %s", sqlResult)
+	}
+
+	jsonOpts := DefaultJSONOptions()
+	jsonOpts.ArrayOnly = true
+	jsonOpts.Location = newYork
+	jsonResult, err := NewJSONExporter(jsonOpts).ExportString(table)
+	if err != nil {
+		t.Fatalf("JSON ExportString() error = %v", err)
+	}
+	if want := `[{"At":"2023-01-14T22:30:00-05:00"}]`; jsonResult != want {
+		t.Errorf("JSON = %s, want %s", jsonResult, want)
+	}
+
+	jsonOpts.DateFormat = "02/01/2006 15:04"
+	jsonResult, err = NewJSONExporter(jsonOpts).ExportString(table)
+	if err != nil {
+		t.Fatalf("JSON ExportString() error = %v", err)
+	}
+	if want := `[{"At":"14/01/2023 22:30"}]`; jsonResult != want {
+		t.Errorf("JSON with DateFormat = %s, want %s", jsonResult, want)
+	}
+}
+
+func TestCSVExporterEncoding(t *testing.T) {
+	table := createTestTable()
+	table.Rows[0].Values["Name"] = models.Cell{Value: "Zoë", Type: models.CellTypeString, RawValue: "Zoë"}
+
+	utf8Opts := DefaultCSVOptions()
+	utf8Opts.Delimiter = '\t'
+	want, err := NewCSVExporter(utf8Opts).ExportString(table)
+	if err != nil {
+		t.Fatalf("ExportString() error = %v", err)
+	}
+
+	tests := []struct {
+		name    string
+		bom     bool
+		wantBOM []byte
+	}{
+		{"no BOM", false, nil},
+		{"with BOM", true, []byte{0xFF, 0xFE}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			opts := DefaultCSVOptions()
+			opts.Delimiter = '\t'
+			opts.Encoding = EncodingUTF16LE
+			opts.WriteBOM = tt.bom
+
+			data, err := NewCSVExporter(opts).ExportBytes(table)
+			if err != nil {
+				t.Fatalf("ExportBytes() error = %v", err)
+			}
+
+			if tt.wantBOM != nil && !bytes.HasPrefix(data, tt.wantBOM) {
+				t.Errorf("Output should start with BOM % x, got % x", tt.wantBOM, data[:2])
+			}
+			if tt.wantBOM == nil && bytes.HasPrefix(data, []byte{0xFF, 0xFE}) {
+				t.Error("Output should not start with a BOM")
+			}
+
+			// UseBOM strips a leading BOM if present
+			decoded, err := unicode.UTF16(unicode.LittleEndian, unicode.UseBOM).NewDecoder().Bytes(data)
+			if err != nil {
+				t.Fatalf("decode error = %v", err)
+			}
+			if string(decoded) != want {
+				t.Errorf("Decoded output = %q, want %q", decoded, want)
+			}
+		})
+	}
+}
+
+func TestCSVExporterUTF8BOM(t *testing.T) {
+	opts := DefaultCSVOptions()
+	opts.WriteBOM = true
+
+	result, err := NewCSVExporter(opts).ExportString(createTestTable())
+	if err != nil {
+		t.Fatalf("ExportString() error = %v", err)
+	}
+	if !strings.HasPrefix(result, "\uFEFFID,") {
+		t.Errorf("Result should start with UTF-8 BOM, got %q", result[:8])
+	}
+}
+
+func TestCSVExporterSanitizeFormulas(t *testing.T) {
+	tests := []struct {
+		value string
+		want  string
+	}{
+		{"=SUM(A1:A9)", "'=SUM(A1:A9)"},
+		{"+1", "'+1"},
+		{"-2+3", "'-2+3"},
+		{"@cmd", "'@cmd"},
+		{"\t=1", "'\t=1"},
+		{"\r=1", "\"'\r=1\""},
+		{"Alice", "Alice"},
+		{"a=b", "a=b"},
+		{"", ""},
+	}
+
+	opts := DefaultCSVOptions()
+	opts.IncludeHeaders = false
+	opts.SanitizeFormulas = true
+	for _, tt := range tests {
+		table := models.NewTableBuilder("T").Headers("Value").AddRow(tt.value).Build()
+		got, err := NewCSVExporter(opts).ExportString(table)
+		if err != nil {
+			t.Fatalf("ExportString(%q) error = %v", tt.value, err)
+		}
+		if got != tt.want+"\n" {
+			t.Errorf("ExportString(%q) = %q, want %q", tt.value, got, tt.want+"\n")
+		}
+	}
+
+	// Numbers are not formulas, and the option is off by default
+	table := models.NewTableBuilder("T").Headers("Value", "Formula").AddRow(-5, "=1+1").Build()
+	for _, o := range []*CSVOptions{opts, DefaultCSVOptions()} {
+		o.IncludeHeaders = false
+		got, err := NewCSVExporter(o).ExportString(table)
+		if err != nil {
+			t.Fatalf("ExportString() error = %v", err)
+		}
+		want := "-5,=1+1\n"
+		if o.SanitizeFormulas {
+			want = "-5,'=1+1\n"
+		}
+		if got != want {
+			t.Errorf("ExportString() with SanitizeFormulas=%v = %q, want %q", o.SanitizeFormulas, got, want)
+		}
+	}
+}
+
+func TestExcelSafeCSVOptions(t *testing.T) {
+	table := models.NewTableBuilder("Report").
+		Headers("Name", "Total").
+		AddRow("=1+1", -5).
+		AddRow("Alice", 10).
+		Build()
+
+	result, err := NewCSVExporter(ExcelSafeCSVOptions()).ExportString(table)
+	if err != nil {
+		t.Fatalf("ExportString() error = %v", err)
+	}
+
+	// The leading quote makes Excel show the cell as the text =1+1
+	want := "\uFEFFName,Total\r\n'=1+1,-5\r\nAlice,10\r\n"
+	if result != want {
+		t.Errorf("ExportString() = %q, want %q", result, want)
+	}
+}
+
+func TestCSVExporterHeaderAliases(t *testing.T) {
+	table := createTestTable()
+	opts := DefaultCSVOptions()
+	opts.SelectedColumns = []string{"ID", "Name"}
+	opts.HeaderAliases = map[string]string{"ID": "user_id", "Name": "user_name"}
+
+	result, err := NewCSVExporter(opts).ExportString(table)
+	if err != nil {
+		t.Fatalf("ExportString() error = %v", err)
+	}
+
+	lines := strings.Split(strings.TrimSpace(result), "\n")
+	if lines[0] != "user_id,user_name" {
+		t.Errorf("header row = %q, want user_id,user_name", lines[0])
+	}
+	if lines[1] != "1,Alice" {
+		t.Errorf("first row = %q, want 1,Alice", lines[1])
+	}
+}
+
+func TestCSVExporterBoolFormat(t *testing.T) {
+	table := createTestTable()
+	opts := DefaultCSVOptions()
+	opts.SelectedColumns = []string{"Name", "Active"}
+	opts.BoolFormat = BoolFormat{True: "Y", False: "N"}
+
+	result, err := NewCSVExporter(opts).ExportString(table)
+	if err != nil {
+		t.Fatalf("ExportString() error = %v", err)
+	}
+
+	want := "Name,Active\nAlice,Y\nBob,N\nCharlie,Y"
+	if got := strings.TrimSpace(result); got != want {
+		t.Errorf("ExportString() = %q, want %q", got, want)
+	}
+
+	// A literal left empty defaults to true/false rather than an empty value
+	opts.BoolFormat = BoolFormat{True: "Y"}
+	result, err = NewCSVExporter(opts).ExportString(table)
+	if err != nil {
+		t.Fatalf("ExportString() error = %v", err)
+	}
+	want = "Name,Active\nAlice,Y\nBob,false\nCharlie,Y"
+	if got := strings.TrimSpace(result); got != want {
+		t.Errorf("half-set ExportString() = %q, want %q", got, want)
+	}
+}
+
+func TestExportersRowTransform(t *testing.T) {
+	table := createTestTable()
+	transform := func(row models.Row) models.Row {
+		row.Values["BatchID"] = models.Cell{Value: "b42", Type: models.CellTypeString, RawValue: "b42"}
+		row.Values["Name"] = models.Cell{Value: "***", Type: models.CellTypeString, RawValue: "***"}
+		return row
+	}
+
+	csvOpts := DefaultCSVOptions()
+	csvOpts.SelectedColumns = []string{"ID", "Name", "BatchID"}
+	csvOpts.RowTransform = transform
+	result, err := NewCSVExporter(csvOpts).ExportString(table)
+	if err != nil {
+		t.Fatalf("CSV ExportString() error = %v", err)
+	}
+	lines := strings.Split(strings.TrimSpace(result), "\n")
+	if lines[0] != "ID,Name,BatchID" || lines[1] != "1,***,b42" {
+		t.Errorf("CSV = %q, want BatchID column and redacted names", result)
+	}
+
+	jsonOpts := DefaultJSONOptions()
+	jsonOpts.ArrayOnly = true
+	jsonOpts.RowTransform = transform
+	result, err = NewJSONExporter(jsonOpts).ExportString(table)
+	if err != nil {
+		t.Fatalf("JSON ExportString() error = %v", err)
+	}
+	var rows []map[string]interface{}
+	if err := json.Unmarshal([]byte(result), &rows); err != nil {
+		t.Fatalf("json.Unmarshal() error = %v", err)
+	}
+	if rows[2]["BatchID"] != "b42" {
+		t.Errorf("rows[2][BatchID] = %v, want b42", rows[2]["BatchID"])
+	}
+
+	// The source table is not modified
+	if _, ok := table.Rows[0].Values["BatchID"]; ok || len(table.Headers) != 5 {
+		t.Error("RowTransform should not modify the exported table")
+	}
+	if name, _ := table.Rows[0].Get("Name"); name.AsString() != "Alice" {
+		t.Errorf("source Name = %q, want Alice", name.AsString())
+	}
+}
+
+func TestExportersRowNumber(t *testing.T) {
+	// Header on sheet row 5, with a skipped row between the data rows
+	table := createTestTable()
+	table.HeaderRow = 4
+	for i, index := range []int{5, 7, 8} {
+		table.Rows[i].Index = index
+	}
+
+	csvOpts := DefaultCSVOptions()
+	csvOpts.IncludeRowNumber = true
+	csvOpts.SelectedColumns = []string{"Name"}
+	result, err := NewCSVExporter(csvOpts).ExportString(table)
+	if err != nil {
+		t.Fatalf("CSV ExportString() error = %v", err)
+	}
+	want := "Row,Name\n6,Alice\n8,Bob\n9,Charlie\n"
+	if result != want {
+		t.Errorf("CSV = %q, want %q", result, want)
+	}
+
+	jsonOpts := DefaultJSONOptions()
+	jsonOpts.ArrayOnly = true
+	jsonOpts.IncludeRowNumber = true
+	jsonOpts.RowNumberHeader = "SourceRow"
+	result, err = NewJSONExporter(jsonOpts).ExportString(table)
+	if err != nil {
+		t.Fatalf("JSON ExportString() error = %v", err)
+	}
+	var rows []map[string]interface{}
+	if err := json.Unmarshal([]byte(result), &rows); err != nil {
+		t.Fatalf("json.Unmarshal() error = %v", err)
+	}
+	for i, want := range []float64{6, 8, 9} {
+		if rows[i]["SourceRow"] != want {
+			t.Errorf("rows[%d][SourceRow] = %v, want %v", i, rows[i]["SourceRow"], want)
+		}
+	}
+
+	// Rows built by hand number from below the header row
+	built := models.NewTableBuilder("Built").Headers("Name").AddRow("a").AddRow("b").Build()
+	result, err = NewCSVExporter(csvOpts).ExportString(built)
+	if err != nil {
+		t.Fatalf("CSV ExportString() error = %v", err)
+	}
+	if result != "Row,Name\n2,a\n3,b\n" {
+		t.Errorf("CSV = %q, want rows 2 and 3", result)
+	}
+
+	if len(table.Headers) != 5 {
+		t.Error("IncludeRowNumber should not modify the exported table")
+	}
+}
+
+func TestExportersRowNumber_HiddenRows(t *testing.T) {
+	f := excelize.NewFile()
+	f.SetSheetRow("Sheet1", "A1", &[]interface{}{"Name", "Score"})
+	f.SetSheetRow("Sheet1", "A2", &[]interface{}{"Hidden", 1})
+	f.SetSheetRow("Sheet1", "A3", &[]interface{}{"Alice", 90})
+	f.SetSheetRow("Sheet1", "A4", &[]interface{}{"Bob", 85})
+	f.SetRowVisible("Sheet1", 2, false)
+	path := filepath.Join(t.TempDir(), "hidden.xlsx")
+	if err := f.SaveAs(path); err != nil {
+		t.Fatalf("SaveAs() error = %v", err)
+	}
+	f.Close()
+
+	config := models.DefaultConfig()
+	config.SkipHidden = true
+	wb, err := reader.NewWorkbookReaderWithConfig(config).ReadFile(path)
+	if err != nil {
+		t.Fatalf("ReadFile() error = %v", err)
+	}
+
+	opts := DefaultCSVOptions()
+	opts.IncludeRowNumber = true
+	result, err := NewCSVExporter(opts).ExportString(&wb.Sheets[0].Tables[0])
+	if err != nil {
+		t.Fatalf("ExportString() error = %v", err)
+	}
+	if want := "Row,Name,Score\n3,Alice,90\n4,Bob,85\n"; result != want {
+		t.Errorf("CSV = %q, want %q", result, want)
+	}
+}
+
+func TestExportersRowNumber_Enriched(t *testing.T) {
+	orders := models.NewTableBuilder("Orders").
+		Headers("Order", "Customer").
+		AddRow("A-1", "C40").
+		Build()
+	customers := models.NewTableBuilder("Customers").Headers("Customer", "Name")
+	for i := 1; i <= 40; i++ {
+		customers.AddRow(fmt.Sprintf("C%d", i), fmt.Sprintf("Name %d", i))
+	}
+
+	// The order sits on sheet row 2; the customer it is enriched from on row 41
+	enriched := orders.Enrich(customers.Build(), "Customer")
+
+	opts := DefaultCSVOptions()
+	opts.IncludeRowNumber = true
+	result, err := NewCSVExporter(opts).ExportString(enriched)
+	if err != nil {
+		t.Fatalf("ExportString() error = %v", err)
+	}
+	if want := "Row,Order,Customer,Name\n2,A-1,C40,Name 40\n"; result != want {
+		t.Errorf("CSV = %q, want %q", result, want)
+	}
+}
+
 func TestCSVConvenienceFunctions(t *testing.T) {
 	table := createTestTable()
 
@@ -371,117 +1042,544 @@ func TestCSVConvenienceFunctions(t *testing.T) {
 	if err != nil {
 		t.Errorf("ToCSVBuffer() error = %v", err)
 	}
-	if buf.Len() == 0 {
-		t.Error("ToCSVBuffer() returned empty buffer")
+	if buf.Len() == 0 {
+		t.Error("ToCSVBuffer() returned empty buffer")
+	}
+}
+
+// ============ SQL Tests ============
+
+func TestSQLExporter(t *testing.T) {
+	table := createTestTable()
+	opts := DefaultSQLOptions()
+	opts.TableName = "users"
+
+	result, err := NewSQLExporter(opts).ExportString(table)
+	if err != nil {
+		t.Fatalf("ExportString() error = %v", err)
+	}
+
+	if !strings.Contains(result, "INSERT INTO") {
+		t.Error("Result should contain INSERT INTO")
+	}
+	if !strings.Contains(result, `"users"`) {
+		t.Errorf("Result should contain table name, got: %s", result)
+	}
+}
+
+func TestSQLExporterWithCreate(t *testing.T) {
+	table := createTestTable()
+	opts := DefaultSQLOptions()
+	opts.TableName = "users"
+	opts.CreateTable = true
+
+	result, err := NewSQLExporter(opts).ExportString(table)
+	if err != nil {
+		t.Fatalf("ExportString() error = %v", err)
+	}
+
+	if !strings.Contains(result, "CREATE TABLE") {
+		t.Error("Result should contain CREATE TABLE")
+	}
+}
+
+func TestSQLExporterWithDrop(t *testing.T) {
+	table := createTestTable()
+	opts := DefaultSQLOptions()
+	opts.TableName = "users"
+	opts.DropTable = true
+
+	result, err := NewSQLExporter(opts).ExportString(table)
+	if err != nil {
+		t.Fatalf("ExportString() error = %v", err)
+	}
+
+	if !strings.Contains(result, "DROP TABLE IF EXISTS") {
+		t.Error("Result should contain DROP TABLE IF EXISTS")
+	}
+}
+
+func TestSQLExporterBatchSize(t *testing.T) {
+	table := createTestTable()
+	opts := DefaultSQLOptions()
+	opts.TableName = "users"
+	opts.BatchSize = 2
+
+	result, err := NewSQLExporter(opts).ExportString(table)
+	if err != nil {
+		t.Fatalf("ExportString() error = %v", err)
+	}
+
+	// Should have 2 INSERT statements
+	insertCount := strings.Count(result, "INSERT INTO")
+	if insertCount != 2 {
+		t.Errorf("Expected 2 INSERT statements, got %d", insertCount)
+	}
+}
+
+func TestSQLExporterDialects(t *testing.T) {
+	table := createTestTable()
+
+	tests := []struct {
+		dialect      SQLDialect
+		expectQuote  string
+		expectBool   string
+	}{
+		{DialectMySQL, "`", "1"},
+		{DialectPostgreSQL, `"`, "TRUE"},
+		{DialectSQLite, `"`, "1"},
+		{DialectGeneric, `"`, "1"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.dialect.String(), func(t *testing.T) {
+			opts := DefaultSQLOptions()
+			opts.TableName = "users"
+			opts.Dialect = tt.dialect
+
+			result, err := NewSQLExporter(opts).ExportString(table)
+			if err != nil {
+				t.Fatalf("ExportString() error = %v", err)
+			}
+
+			if !strings.Contains(result, tt.expectQuote+"users"+tt.expectQuote) {
+				t.Errorf("Expected %s quoting style", tt.expectQuote)
+			}
+
+			if !strings.Contains(result, tt.expectBool) {
+				t.Errorf("Expected boolean value %s in output", tt.expectBool)
+			}
+		})
+	}
+}
+
+func TestSQLExporterIdentifierCase(t *testing.T) {
+	table := createTestTable()
+
+	tests := []struct {
+		name       string
+		idCase     IdentifierCase
+		wantTable  string
+		wantColumn string
+	}{
+		{"preserve", CasePreserve, `"UserData"`, `"JoinDate"`},
+		{"lower", CaseLower, `"userdata"`, `"joindate"`},
+		{"upper", CaseUpper, `"USERDATA"`, `"JOINDATE"`},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			opts := DefaultSQLOptions()
+			opts.TableName = "UserData"
+			opts.Dialect = DialectPostgreSQL
+			opts.CreateTable = true
+			opts.IdentifierCase = tt.idCase
+
+			result, err := NewSQLExporter(opts).ExportString(table)
+			if err != nil {
+				t.Fatalf("ExportString() error = %v", err)
+			}
+
+			if !strings.Contains(result, "CREATE TABLE "+tt.wantTable) {
+				t.Errorf("Expected table name %s in CREATE TABLE, got:\n%s", tt.wantTable, result)
+			}
+			if !strings.Contains(result, "INSERT INTO "+tt.wantTable) {
+				t.Errorf("Expected table name %s in INSERT, got:\n%s", tt.wantTable, result)
+			}
+			if !strings.Contains(result, "    "+tt.wantColumn+" ") {
+				t.Errorf("Expected column %s in CREATE TABLE, got:\n%s", tt.wantColumn, result)
+			}
+			if !strings.Contains(result, tt.wantColumn+")") {
+				t.Errorf("Expected column %s in INSERT column list, got:\n%s", tt.wantColumn, result)
+			}
+			// Values are unaffected by identifier folding
+			if !strings.Contains(result, "'Alice'") {
+				t.Error("Expected row values to be preserved")
+			}
+		})
+	}
+}
+
+func TestSQLExporterBoolFormat(t *testing.T) {
+	table := createTestTable()
+	opts := DefaultSQLOptions()
+	opts.TableName = "users"
+	opts.CreateTable = true
+	opts.SelectedColumns = []string{"ID", "Active"}
+	opts.BoolFormat = BoolFormat{True: "Y", False: "N"}
+
+	result, err := NewSQLExporter(opts).ExportString(table)
+	if err != nil {
+		t.Fatalf("ExportString() error = %v", err)
+	}
+	if !strings.Contains(result, `"Active" TEXT`) {
+		t.Errorf("Expected text column for formatted booleans, got:\n%s", result)
+	}
+	if !strings.Contains(result, "(1, 'Y')") || !strings.Contains(result, "(2, 'N')") {
+		t.Errorf("Expected Y/N literals, got:\n%s", result)
+	}
+
+	// PostgreSQL keeps its native BOOLEAN type
+	opts.Dialect = DialectPostgreSQL
+	result, err = NewSQLExporter(opts).ExportString(table)
+	if err != nil {
+		t.Fatalf("ExportString() error = %v", err)
+	}
+	if !strings.Contains(result, `"Active" BOOLEAN`) || !strings.Contains(result, "(1, TRUE)") {
+		t.Errorf("Expected native booleans for PostgreSQL, got:\n%s", result)
 	}
 }
 
-// ============ SQL Tests ============
-
-func TestSQLExporter(t *testing.T) {
+func TestSQLExporterHeaderAliases(t *testing.T) {
 	table := createTestTable()
 	opts := DefaultSQLOptions()
 	opts.TableName = "users"
+	opts.CreateTable = true
+	opts.SelectedColumns = []string{"ID", "Name"}
+	opts.HeaderAliases = map[string]string{"Name": "user_name"}
 
 	result, err := NewSQLExporter(opts).ExportString(table)
 	if err != nil {
 		t.Fatalf("ExportString() error = %v", err)
 	}
 
-	if !strings.Contains(result, "INSERT INTO") {
-		t.Error("Result should contain INSERT INTO")
+	if !strings.Contains(result, `"user_name" TEXT`) {
+		t.Errorf("Expected aliased column in CREATE TABLE, got:\n%s", result)
 	}
-	if !strings.Contains(result, `"users"`) {
-		t.Errorf("Result should contain table name, got: %s", result)
+	if !strings.Contains(result, `("ID", "user_name") VALUES`) {
+		t.Errorf("Expected aliased column in INSERT, got:\n%s", result)
+	}
+	if !strings.Contains(result, "'Alice'") {
+		t.Error("Expected row values bound by original header")
 	}
 }
 
-func TestSQLExporterWithCreate(t *testing.T) {
+func TestSQLExporterCopyMode(t *testing.T) {
 	table := createTestTable()
 	opts := DefaultSQLOptions()
 	opts.TableName = "users"
-	opts.CreateTable = true
+	opts.Dialect = DialectPostgreSQL
+	opts.CopyMode = true
 
 	result, err := NewSQLExporter(opts).ExportString(table)
 	if err != nil {
 		t.Fatalf("ExportString() error = %v", err)
 	}
 
-	if !strings.Contains(result, "CREATE TABLE") {
-		t.Error("Result should contain CREATE TABLE")
+	lines := strings.Split(strings.TrimSuffix(result, "\n"), "\n")
+	if len(lines) != 5 {
+		t.Fatalf("Expected 5 lines (header, 3 rows, terminator), got %d:\n%s", len(lines), result)
+	}
+
+	wantHeader := `COPY "users" ("ID", "Name", "Age", "Active", "JoinDate") FROM STDIN;`
+	if lines[0] != wantHeader {
+		t.Errorf("header = %q, want %q", lines[0], wantHeader)
+	}
+	if lines[1] != "1\tAlice\t30\tt\t2023-01-15 00:00:00" {
+		t.Errorf("row 1 = %q", lines[1])
+	}
+	if lines[3] != "3\tCharlie\t\\N\tt\t\\N" {
+		t.Errorf("row 3 = %q, want nulls as \\N", lines[3])
+	}
+	if lines[4] != `\.` {
+		t.Errorf("terminator = %q, want \\.", lines[4])
+	}
+	if strings.Contains(result, "INSERT INTO") {
+		t.Error("COPY mode should not emit INSERT statements")
 	}
 }
 
-func TestSQLExporterWithDrop(t *testing.T) {
+func TestSQLExporterInsertIfNotExists(t *testing.T) {
 	table := createTestTable()
 	opts := DefaultSQLOptions()
 	opts.TableName = "users"
-	opts.DropTable = true
+	opts.InsertIfNotExists = true
+	opts.KeyColumns = []string{"ID", "Name"}
 
 	result, err := NewSQLExporter(opts).ExportString(table)
 	if err != nil {
 		t.Fatalf("ExportString() error = %v", err)
 	}
 
-	if !strings.Contains(result, "DROP TABLE IF EXISTS") {
-		t.Error("Result should contain DROP TABLE IF EXISTS")
+	if n := strings.Count(result, "INSERT INTO"); n != 3 {
+		t.Errorf("Expected 3 INSERT statements, got %d:\n%s", n, result)
+	}
+	want := `WHERE NOT EXISTS (SELECT 1 FROM "users" WHERE "ID" = 1 AND "Name" = 'Alice');`
+	if !strings.Contains(result, want) {
+		t.Errorf("Expected %q in:\n%s", want, result)
+	}
+	if strings.Contains(result, "VALUES") {
+		t.Error("InsertIfNotExists should use INSERT ... SELECT, not VALUES")
+	}
+
+	opts.Dialect = DialectMySQL
+	result, err = NewSQLExporter(opts).ExportString(table)
+	if err != nil {
+		t.Fatalf("ExportString() error = %v", err)
+	}
+	if !strings.Contains(result, "FROM DUAL\nWHERE NOT EXISTS (SELECT 1 FROM `users` WHERE `ID` = 1") {
+		t.Errorf("MySQL output should select FROM DUAL:\n%s", result)
 	}
 }
 
-func TestSQLExporterBatchSize(t *testing.T) {
-	table := createTestTable()
+func TestSQLExporterSQLiteStrictTypes(t *testing.T) {
+	table := models.NewTableBuilder("Orders").
+		Headers("ID", "Name", "Amount", "Paid").
+		AddRow(1, "Alice", "12.50", true).
+		AddRow(2, "Bob", "3", false).
+		AddRow(3, "Charlie", nil, true).
+		Build()
+
 	opts := DefaultSQLOptions()
-	opts.TableName = "users"
-	opts.BatchSize = 2
+	opts.TableName = "orders"
+	opts.Dialect = DialectSQLite
+	opts.CreateTable = true
+	opts.SQLiteStrictTypes = true
 
 	result, err := NewSQLExporter(opts).ExportString(table)
 	if err != nil {
 		t.Fatalf("ExportString() error = %v", err)
 	}
 
-	// Should have 2 INSERT statements
-	insertCount := strings.Count(result, "INSERT INTO")
-	if insertCount != 2 {
-		t.Errorf("Expected 2 INSERT statements, got %d", insertCount)
+	for _, want := range []string{
+		`"ID" INTEGER`,
+		`"Amount" REAL`,
+		`"Paid" INTEGER`,
+		"(CAST(1 AS INTEGER), 'Alice', CAST('12.50' AS REAL), CAST(1 AS INTEGER))",
+		"(CAST(3 AS INTEGER), 'Charlie', NULL, CAST(1 AS INTEGER))",
+	} {
+		if !strings.Contains(result, want) {
+			t.Errorf("Expected %q in:\n%s", want, result)
+		}
+	}
+	if strings.Contains(result, "CAST('Alice'") {
+		t.Errorf("text column should not be cast:\n%s", result)
+	}
+
+	opts.Dialect = DialectPostgreSQL
+	result, err = NewSQLExporter(opts).ExportString(table)
+	if err != nil {
+		t.Fatalf("ExportString() error = %v", err)
+	}
+	if strings.Contains(result, "CAST(") {
+		t.Errorf("SQLiteStrictTypes should only apply to SQLite:\n%s", result)
 	}
 }
 
-func TestSQLExporterDialects(t *testing.T) {
+func TestSQLExporterInsertIfNotExistsKeyErrors(t *testing.T) {
 	table := createTestTable()
-
 	tests := []struct {
-		dialect      SQLDialect
-		expectQuote  string
-		expectBool   string
+		name string
+		keys []string
 	}{
-		{DialectMySQL, "`", "1"},
-		{DialectPostgreSQL, `"`, "TRUE"},
-		{DialectSQLite, `"`, "1"},
-		{DialectGeneric, `"`, "1"},
+		{"no keys", nil},
+		{"unknown key", []string{"Missing"}},
 	}
 
 	for _, tt := range tests {
-		t.Run(tt.dialect.String(), func(t *testing.T) {
+		t.Run(tt.name, func(t *testing.T) {
 			opts := DefaultSQLOptions()
-			opts.TableName = "users"
-			opts.Dialect = tt.dialect
-
-			result, err := NewSQLExporter(opts).ExportString(table)
-			if err != nil {
-				t.Fatalf("ExportString() error = %v", err)
+			opts.InsertIfNotExists = true
+			opts.KeyColumns = tt.keys
+			if _, err := NewSQLExporter(opts).ExportString(table); err == nil {
+				t.Error("Expected error for invalid key columns")
 			}
+		})
+	}
+}
 
-			if !strings.Contains(result, tt.expectQuote+"users"+tt.expectQuote) {
-				t.Errorf("Expected %s quoting style", tt.expectQuote)
-			}
+func TestBuildLoadQuery(t *testing.T) {
+	headers := []string{"ID", "Name"}
+	tests := []struct {
+		name    string
+		dialect SQLDialect
+		upsert  bool
+		keys    []string
+		want    string
+		wantErr bool
+	}{
+		{"insert", DialectSQLite, false, nil, `INSERT INTO "people" ("ID", "Name") VALUES (?, ?)`, false},
+		{"postgres placeholders", DialectPostgreSQL, false, nil, `INSERT INTO "people" ("ID", "Name") VALUES ($1, $2)`, false},
+		{"upsert", DialectSQLite, true, []string{"ID"},
+			`INSERT INTO "people" ("ID", "Name") VALUES (?, ?) ON CONFLICT ("ID") DO UPDATE SET "Name" = excluded."Name"`, false},
+		{"mysql upsert", DialectMySQL, true, []string{"ID"},
+			"INSERT INTO `people` (`ID`, `Name`) VALUES (?, ?) ON DUPLICATE KEY UPDATE `Name` = VALUES(`Name`)", false},
+		{"upsert without keys", DialectSQLite, true, nil, "", true},
+		{"unknown key", DialectSQLite, true, []string{"Email"}, "", true},
+	}
 
-			if !strings.Contains(result, tt.expectBool) {
-				t.Errorf("Expected boolean value %s in output", tt.expectBool)
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			e := NewSQLExporter(&SQLOptions{TableName: "people", Dialect: tt.dialect, KeyColumns: tt.keys})
+			got, err := e.buildLoadQuery(headers, tt.upsert)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("buildLoadQuery() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if got != tt.want {
+				t.Errorf("buildLoadQuery() = %q, want %q", got, tt.want)
 			}
 		})
 	}
 }
 
+func TestLoadToDB_InvalidOptions(t *testing.T) {
+	table := createTestTable()
+	if _, err := LoadToDB(context.Background(), table, nil, DefaultSQLLoadOptions()); err == nil {
+		t.Error("LoadToDB() with a nil database should return an error")
+	}
+}
+
+func TestDiffToSQL(t *testing.T) {
+	oldTable := createTestTable()
+	newTable := createTestTable()
+	// Drop Bob, give Alice a birthday and add Dave
+	newTable.Rows = []models.Row{newTable.Rows[0], newTable.Rows[2]}
+	newTable.Rows[0].Values = map[string]models.Cell{}
+	for k, v := range oldTable.Rows[0].Values {
+		newTable.Rows[0].Values[k] = v
+	}
+	newTable.Rows[0].Values["Age"] = models.Cell{Value: float64(31), Type: models.CellTypeNumber, RawValue: "31"}
+	newTable.Rows = append(newTable.Rows, models.Row{
+		Index: 4,
+		Values: map[string]models.Cell{
+			"ID":   {Value: float64(4), Type: models.CellTypeNumber, RawValue: "4"},
+			"Name": {Value: "Dave", Type: models.CellTypeString, RawValue: "Dave"},
+		},
+	})
+
+	diff := models.DiffTables(oldTable, newTable, "ID")
+	sql, err := DiffToSQL(diff, "users", *DefaultSQLOptions())
+	if err != nil {
+		t.Fatalf("DiffToSQL() error = %v", err)
+	}
+
+	want := []string{
+		`DELETE FROM "users" WHERE "ID" = 2;`,
+		`UPDATE "users" SET "Age" = 31 WHERE "ID" = 1;`,
+		`INSERT INTO "users" ("ID", "Name") VALUES (4, 'Dave');`,
+	}
+	if got := strings.Split(sql, "\n"); len(got) != len(want) {
+		t.Fatalf("DiffToSQL() = %q, want %d statements", sql, len(want))
+	} else {
+		for i := range want {
+			if got[i] != want[i] {
+				t.Errorf("statement %d = %q, want %q", i, got[i], want[i])
+			}
+		}
+	}
+
+	if _, err := DiffToSQL(models.DiffResult{}, "users", *DefaultSQLOptions()); err == nil {
+		t.Error("DiffToSQL() without a key column should return an error")
+	}
+}
+
+func TestDiffToJSON(t *testing.T) {
+	oldTable := createTestTable()
+	newTable := createTestTable()
+	newTable.Rows = newTable.Rows[:2]
+	newTable.Rows[0] = models.Row{Index: 1, Values: map[string]models.Cell{}}
+	for k, v := range oldTable.Rows[0].Values {
+		newTable.Rows[0].Values[k] = v
+	}
+	newTable.Rows[0].Values["Age"] = models.Cell{Value: float64(31), Type: models.CellTypeNumber, RawValue: "31"}
+
+	out, err := DiffToJSON(models.DiffTables(oldTable, newTable, "ID"), true)
+	if err != nil {
+		t.Fatalf("DiffToJSON() error = %v", err)
+	}
+
+	var doc struct {
+		KeyColumn string                   `json:"keyColumn"`
+		Added     []map[string]interface{} `json:"added"`
+		Removed   []map[string]interface{} `json:"removed"`
+		Modified  []struct {
+			Key     string `json:"key"`
+			Changes []struct {
+				Column string      `json:"column"`
+				Old    interface{} `json:"old"`
+				New    interface{} `json:"new"`
+			} `json:"changes"`
+		} `json:"modified"`
+	}
+	if err := json.Unmarshal([]byte(out), &doc); err != nil {
+		t.Fatalf("DiffToJSON() produced invalid JSON: %v\n%s", err, out)
+	}
+
+	if doc.KeyColumn != "ID" || len(doc.Added) != 0 {
+		t.Errorf("keyColumn = %q, added = %v, want ID and no added rows", doc.KeyColumn, doc.Added)
+	}
+	if len(doc.Removed) != 1 || doc.Removed[0]["Name"] != "Charlie" {
+		t.Errorf("removed = %v, want Charlie", doc.Removed)
+	}
+	if len(doc.Modified) != 1 || doc.Modified[0].Key != "1" || len(doc.Modified[0].Changes) != 1 {
+		t.Fatalf("modified = %+v, want one change to row 1", doc.Modified)
+	}
+	change := doc.Modified[0].Changes[0]
+	if change.Column != "Age" || change.Old != float64(30) || change.New != float64(31) {
+		t.Errorf("change = %+v, want Age 30 -> 31", change)
+	}
+}
+
+func TestToASCIITable(t *testing.T) {
+	table := createTestTable()
+	opts := DefaultASCIITableOptions()
+	opts.SelectedColumns = []string{"Name", "Age"}
+
+	got := ToASCIITable(table, opts)
+	want := "" +
+		"+---------+-----+\n" +
+		"| Name    | Age |\n" +
+		"+---------+-----+\n" +
+		"| Alice   |  30 |\n" +
+		"| Bob     |  25 |\n" +
+		"| Charlie |     |\n" +
+		"+---------+-----+\n"
+	if got != want {
+		t.Errorf("ToASCIITable() =\n%s\nwant\n%s", got, want)
+	}
+}
+
+func TestToASCIITableTruncation(t *testing.T) {
+	table := &models.Table{
+		Headers: []string{"Note"},
+		Rows: []models.Row{
+			{Values: map[string]models.Cell{"Note": {Value: "a very long note", Type: models.CellTypeString, RawValue: "a very long note"}}},
+		},
+	}
+	opts := DefaultASCIITableOptions()
+	opts.MaxColumnWidth = 6
+
+	got := ToASCIITable(table, opts)
+	if !strings.Contains(got, "| a ver… |") {
+		t.Errorf("ToASCIITable() = %q, want the note truncated to 'a ver…'", got)
+	}
+	for _, line := range strings.Split(strings.TrimSuffix(got, "\n"), "\n") {
+		if n := len([]rune(line)); n != 10 {
+			t.Errorf("line %q is %d runes wide, want 10", line, n)
+		}
+	}
+}
+
+func TestSQLExporterCopyModeEscaping(t *testing.T) {
+	table := &models.Table{
+		Headers: []string{"Text"},
+		Rows: []models.Row{
+			{Values: map[string]models.Cell{"Text": {Value: "a\tb\nc\\d", Type: models.CellTypeString, RawValue: "a\tb\nc\\d"}}},
+		},
+	}
+	opts := DefaultSQLOptions()
+	opts.CopyMode = true
+
+	result, err := NewSQLExporter(opts).ExportString(table)
+	if err != nil {
+		t.Fatalf("ExportString() error = %v", err)
+	}
+
+	if !strings.Contains(result, "\na\\tb\\nc\\\\d\n") {
+		t.Errorf("Special characters not escaped: %q", result)
+	}
+}
+
 func TestSQLExporterEscaping(t *testing.T) {
 	table := &models.Table{
 		Name:    "Test",
@@ -570,6 +1668,72 @@ func TestSQLDialectString(t *testing.T) {
 	}
 }
 
+// ============ Zip Tests ============
+
+func TestToZip(t *testing.T) {
+	table := createTestTable()
+	buf := &bytes.Buffer{}
+
+	if err := ToZip(table, []Format{FormatCSV, FormatJSON, FormatSQL}, buf); err != nil {
+		t.Fatalf("ToZip() error = %v", err)
+	}
+
+	zr, err := zip.NewReader(bytes.NewReader(buf.Bytes()), int64(buf.Len()))
+	if err != nil {
+		t.Fatalf("zip.NewReader() error = %v", err)
+	}
+
+	want := []string{"TestTable.csv", "TestTable.json", "TestTable.sql"}
+	if len(zr.File) != len(want) {
+		t.Fatalf("Expected %d entries, got %d", len(want), len(zr.File))
+	}
+	for i, f := range zr.File {
+		if f.Name != want[i] {
+			t.Errorf("entry %d = %q, want %q", i, f.Name, want[i])
+		}
+		rc, err := f.Open()
+		if err != nil {
+			t.Fatalf("Open(%s) error = %v", f.Name, err)
+		}
+		data, err := io.ReadAll(rc)
+		rc.Close()
+		if err != nil {
+			t.Fatalf("ReadAll(%s) error = %v", f.Name, err)
+		}
+		if len(data) == 0 {
+			t.Errorf("entry %s is empty", f.Name)
+		}
+	}
+}
+
+func TestToZip_Errors(t *testing.T) {
+	table := createTestTable()
+
+	if err := ToZip(table, []Format{FormatCSV, FormatCSV}, io.Discard); err == nil {
+		t.Error("Expected error for duplicate format")
+	}
+	if err := ToZip(table, []Format{Format(99)}, io.Discard); err == nil {
+		t.Error("Expected error for unsupported format")
+	}
+}
+
+func TestToZip_UnnamedTable(t *testing.T) {
+	table := createTestTable()
+	table.Name = ""
+	buf := &bytes.Buffer{}
+
+	if err := ToZip(table, []Format{FormatJSON}, buf); err != nil {
+		t.Fatalf("ToZip() error = %v", err)
+	}
+	zr, err := zip.NewReader(bytes.NewReader(buf.Bytes()), int64(buf.Len()))
+	if err != nil {
+		t.Fatalf("zip.NewReader() error = %v", err)
+	}
+	if len(zr.File) != 1 || zr.File[0].Name != "table.json" {
+		t.Errorf("Expected single entry table.json")
+	}
+}
+
 // ============ Edge Case Tests ============
 
 func TestExportEmptyTable(t *testing.T) {
@@ -1100,3 +2264,214 @@ func BenchmarkSQLExport(b *testing.B) {
 		exporter.ExportString(table)
 	}
 }
+
+func TestXLSXStreamWriter(t *testing.T) {
+	var buf bytes.Buffer
+	sw, err := NewXLSXStreamWriter(&buf, "Data", []string{"ID", "Name", "Active", "Joined"})
+	if err != nil {
+		t.Fatalf("NewXLSXStreamWriter() error = %v", err)
+	}
+
+	joined := time.Date(2023, 1, 15, 0, 0, 0, 0, time.UTC)
+	const rows = 10000
+	for i := 1; i <= rows; i++ {
+		cells := []models.Cell{
+			{Value: float64(i), Type: models.CellTypeNumber, RawValue: fmt.Sprint(i)},
+			{Value: fmt.Sprintf("user%d", i), Type: models.CellTypeString, RawValue: fmt.Sprintf("user%d", i)},
+			{Value: i%2 == 0, Type: models.CellTypeBool, RawValue: fmt.Sprint(i%2 == 0)},
+			{Type: models.CellTypeEmpty},
+		}
+		if i == rows {
+			cells[3] = models.Cell{Value: joined, Type: models.CellTypeDate, RawValue: "2023-01-15"}
+		}
+		if err := sw.AddRow(cells); err != nil {
+			t.Fatalf("AddRow(%d) error = %v", i, err)
+		}
+	}
+	if err := sw.Close(); err != nil {
+		t.Fatalf("Close() error = %v", err)
+	}
+	if err := sw.AddRow(nil); err == nil {
+		t.Error("AddRow() after Close should return an error")
+	}
+
+	f, err := excelize.OpenReader(&buf)
+	if err != nil {
+		t.Fatalf("OpenReader() error = %v", err)
+	}
+	defer f.Close()
+
+	tests := []struct {
+		cell string
+		want string
+	}{
+		{"A1", "ID"},
+		{"D1", "Joined"},
+		{"A2", "1"},
+		{"B2", "user1"},
+		{"C2", "FALSE"},
+		{"D2", ""},
+		{"B5001", "user5000"},
+		{"C5001", "TRUE"},
+		{"A10001", "10000"},
+	}
+	for _, tt := range tests {
+		got, err := f.GetCellValue("Data", tt.cell)
+		if err != nil {
+			t.Fatalf("GetCellValue(%s) error = %v", tt.cell, err)
+		}
+		if got != tt.want {
+			t.Errorf("%s = %q, want %q", tt.cell, got, tt.want)
+		}
+	}
+	if got, _ := f.GetCellValue("Data", "D10001", excelize.Options{RawCellValue: true}); got != "44941" {
+		t.Errorf("D10001 serial = %q, want 44941", got)
+	}
+
+	rowsRead, err := f.GetRows("Data")
+	if err != nil {
+		t.Fatalf("GetRows() error = %v", err)
+	}
+	if len(rowsRead) != rows+1 {
+		t.Errorf("rows = %d, want %d", len(rowsRead), rows+1)
+	}
+}
+
+func TestExportEach(t *testing.T) {
+	var tables []*models.Table
+	for i := 1; i <= 8; i++ {
+		table := createTestTable()
+		table.Name = fmt.Sprintf("Table%d", i)
+		tables = append(tables, table)
+	}
+	dir := filepath.Join(t.TempDir(), "out")
+
+	if err := ExportEach(tables, dir, FormatCSV, nil, 3); err != nil {
+		t.Fatalf("ExportEach() error = %v", err)
+	}
+
+	want, err := NewCSVExporter(nil).ExportString(tables[0])
+	if err != nil {
+		t.Fatalf("ExportString() error = %v", err)
+	}
+	for _, table := range tables {
+		data, err := os.ReadFile(filepath.Join(dir, table.Name+".csv"))
+		if err != nil {
+			t.Fatalf("ReadFile(%s) error = %v", table.Name, err)
+		}
+		if string(data) != want {
+			t.Errorf("%s.csv = %q, want %q", table.Name, data, want)
+		}
+	}
+
+	if err := ExportEach([]*models.Table{tables[0], tables[0]}, dir, FormatJSON, nil, 2); err == nil {
+		t.Error("ExportEach() with duplicate table names should return an error")
+	}
+	if err := ExportEach(tables, dir, FormatJSON, DefaultCSVOptions(), 2); err == nil {
+		t.Error("ExportEach() with mismatched options should return an error")
+	}
+}
+
+func TestWorkbookToXLSX_RoundTrip(t *testing.T) {
+	people := createTestTable()
+	people.Name = "People"
+	cities := models.NewTableBuilder("Cities").
+		Headers("City", "Country", "Population").
+		AddRow("Paris", "FR", 2100000).
+		AddRow("Tunis", "TN", 640000).
+		Build()
+	orders := models.NewTableBuilder("Orders").
+		Headers("OrderID", "Amount").
+		AddRow("O1", 99.5).
+		AddRow("O2", 12).
+		Build()
+
+	wb := &models.Workbook{Sheets: []models.Sheet{
+		{Name: "Main", Tables: []models.Table{*people, *cities}},
+		{Name: "Sales", Tables: []models.Table{*orders}},
+	}}
+
+	path := filepath.Join(t.TempDir(), "roundtrip.xlsx")
+	f, err := os.Create(path)
+	if err != nil {
+		t.Fatalf("Create() error = %v", err)
+	}
+	if err := WorkbookToXLSX(wb, f, DefaultXLSXOptions()); err != nil {
+		t.Fatalf("WorkbookToXLSX() error = %v", err)
+	}
+	f.Close()
+
+	got, err := reader.NewWorkbookReader().ReadFile(path)
+	if err != nil {
+		t.Fatalf("ReadFile() error = %v", err)
+	}
+	if len(got.Sheets) != 2 || got.Sheets[0].Name != "Main" || got.Sheets[1].Name != "Sales" {
+		t.Fatalf("sheets = %+v, want Main and Sales", got.Sheets)
+	}
+	if n := len(got.Sheets[0].Tables); n != 2 {
+		t.Fatalf("Main tables = %d, want 2", n)
+	}
+
+	tests := []struct {
+		table *models.Table
+		want  *models.Table
+	}{
+		{&got.Sheets[0].Tables[0], people},
+		{&got.Sheets[0].Tables[1], cities},
+		{&got.Sheets[1].Tables[0], orders},
+	}
+	for _, tt := range tests {
+		if h, w := strings.Join(tt.table.Headers, ","), strings.Join(tt.want.Headers, ","); h != w {
+			t.Errorf("%s headers = %q, want %q", tt.want.Name, h, w)
+		}
+		if tt.table.RowCount() != tt.want.RowCount() {
+			t.Errorf("%s rows = %d, want %d", tt.want.Name, tt.table.RowCount(), tt.want.RowCount())
+		}
+	}
+	if name := got.Sheets[0].Tables[0].Rows[1].Values["Name"]; name.AsString() != "Bob" {
+		t.Errorf("People Name[1] = %q, want Bob", name.AsString())
+	}
+	if amount := got.Sheets[1].Tables[0].Rows[0].Values["Amount"]; amount.Value != 99.5 {
+		t.Errorf("Orders Amount[0] = %v, want 99.5", amount.Value)
+	}
+	joined := got.Sheets[0].Tables[0].Rows[0].Values["JoinDate"]
+	if d, ok := joined.AsTime(); !ok || !d.Equal(time.Date(2023, 1, 15, 0, 0, 0, 0, time.UTC)) {
+		t.Errorf("People JoinDate[0] = %v, want 2023-01-15", joined.Value)
+	}
+
+	// One sheet per table
+	var buf bytes.Buffer
+	opts := DefaultXLSXOptions()
+	opts.SheetPerTable = true
+	if err := WorkbookToXLSX(wb, &buf, opts); err != nil {
+		t.Fatalf("WorkbookToXLSX(SheetPerTable) error = %v", err)
+	}
+	xf, err := excelize.OpenReader(&buf)
+	if err != nil {
+		t.Fatalf("OpenReader() error = %v", err)
+	}
+	defer xf.Close()
+	if sheets := strings.Join(xf.GetSheetList(), ","); sheets != "People,Cities,Orders" {
+		t.Errorf("sheets = %q, want People,Cities,Orders", sheets)
+	}
+}
+
+func TestXLSXSheetName(t *testing.T) {
+	used := make(map[string]bool)
+	tests := []struct {
+		name string
+		want string
+	}{
+		{"Sales", "Sales"},
+		{"sales", "sales (2)"},
+		{"Q1/Q2: [draft]?", "Q1_Q2_ _draft__"},
+		{"", "Sheet"},
+		{strings.Repeat("x", 40), strings.Repeat("x", 31)},
+		{strings.Repeat("x", 40), strings.Repeat("x", 27) + " (2)"},
+	}
+	for _, tt := range tests {
+		if got := xlsxSheetName(tt.name, used); got != tt.want {
+			t.Errorf("xlsxSheetName(%q) = %q, want %q", tt.name, got, tt.want)
+		}
+	}
+}
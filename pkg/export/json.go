@@ -3,7 +3,9 @@ package export
 import (
 	"bytes"
 	"encoding/json"
+	"fmt"
 	"io"
+	"time"
 
 	"github.com/meddhiazoghlami/goxls/pkg/models"
 )
@@ -20,8 +22,20 @@ type JSONOptions struct {
 
 	// ArrayOnly outputs just the array without wrapping object
 	ArrayOnly bool
+
+	// MetadataFields lists the fields of the wrapping object to write, from
+	// "name", "headers", "rows" and "count". Empty writes all of them.
+	MetadataFields []string
+
+	// ExtraMetadata holds additional fields added to the wrapping object,
+	// such as an export timestamp or source file. Keys must not clash with
+	// the written MetadataFields.
+	ExtraMetadata map[string]interface{}
 }
 
+// jsonMetadataFields are the fields of the wrapping object, in default order
+var jsonMetadataFields = []string{"name", "headers", "rows", "count"}
+
 // DefaultJSONOptions returns sensible defaults for JSON export
 func DefaultJSONOptions() *JSONOptions {
 	return &JSONOptions{
@@ -57,7 +71,8 @@ func (e *JSONExporter) Export(table *models.Table, w io.Writer) error {
 
 // ExportBytes returns the table as JSON bytes
 func (e *JSONExporter) ExportBytes(table *models.Table) ([]byte, error) {
-	headers, filter := filterColumns(table, e.opts.SelectedColumns)
+	table = e.opts.transformTable(table)
+	headers, filter := filterColumns(table, e.opts.selectedColumns())
 
 	// Build rows as slice of maps
 	rows := make([]map[string]interface{}, 0, len(table.Rows))
@@ -66,10 +81,18 @@ func (e *JSONExporter) ExportBytes(table *models.Table) ([]byte, error) {
 		for _, header := range headers {
 			if filter[header] {
 				cell, ok := row.Values[header]
+				key := e.opts.outputHeader(header)
 				if ok {
-					rowMap[header] = getCellValue(cell, e.opts.NullValue)
+					value := getCellValue(cell, e.opts.NullValue)
+					if t, isDate := cell.Value.(time.Time); isDate && !cell.IsEmpty() {
+						value = e.opts.formatDate(t, "")
+					}
+					if b, isBool := value.(bool); isBool && e.opts.BoolFormat.isSet() {
+						value = e.opts.BoolFormat.format(b)
+					}
+					rowMap[key] = value
 				} else {
-					rowMap[header] = nil
+					rowMap[key] = nil
 				}
 			}
 		}
@@ -80,12 +103,11 @@ func (e *JSONExporter) ExportBytes(table *models.Table) ([]byte, error) {
 	if e.opts.ArrayOnly {
 		output = rows
 	} else {
-		output = map[string]interface{}{
-			"name":    table.Name,
-			"headers": headers,
-			"rows":    rows,
-			"count":   len(rows),
+		envelope, err := e.envelope(table, headers, rows)
+		if err != nil {
+			return nil, err
 		}
+		output = envelope
 	}
 
 	if e.opts.Pretty {
@@ -94,6 +116,37 @@ func (e *JSONExporter) ExportBytes(table *models.Table) ([]byte, error) {
 	return json.Marshal(output)
 }
 
+// envelope builds the wrapping object from MetadataFields and ExtraMetadata
+func (e *JSONExporter) envelope(table *models.Table, headers []string, rows []map[string]interface{}) (map[string]interface{}, error) {
+	fields := e.opts.MetadataFields
+	if len(fields) == 0 {
+		fields = jsonMetadataFields
+	}
+
+	envelope := make(map[string]interface{}, len(fields)+len(e.opts.ExtraMetadata))
+	for _, field := range fields {
+		switch field {
+		case "name":
+			envelope[field] = table.Name
+		case "headers":
+			envelope[field] = e.opts.outputHeaders(headers)
+		case "rows":
+			envelope[field] = rows
+		case "count":
+			envelope[field] = len(rows)
+		default:
+			return nil, fmt.Errorf("unknown JSON metadata field: %s", field)
+		}
+	}
+	for key, value := range e.opts.ExtraMetadata {
+		if _, exists := envelope[key]; exists {
+			return nil, fmt.Errorf("extra JSON metadata %s clashes with a metadata field", key)
+		}
+		envelope[key] = value
+	}
+	return envelope, nil
+}
+
 // ExportString returns the table as a JSON string
 func (e *JSONExporter) ExportString(table *models.Table) (string, error) {
 	data, err := e.ExportBytes(table)
@@ -0,0 +1,68 @@
+package export
+
+import (
+	"fmt"
+	"strings"
+	"sync"
+)
+
+// ExporterFactory creates an exporter for a registered format.
+// opts is whatever was passed to NewExporter and may be nil.
+type ExporterFactory func(opts interface{}) Exporter
+
+// registeredFormat holds a custom format added with RegisterFormat
+type registeredFormat struct {
+	name    string
+	factory ExporterFactory
+}
+
+var (
+	registryMu sync.RWMutex
+	registry   = make(map[Format]registeredFormat)
+	formatIDs  = make(map[string]Format)
+	nextFormat = FormatSQL + 1
+)
+
+// RegisterFormat adds a custom export format and returns its Format value.
+// Once registered, the name is accepted by ParseFormat and the format works
+// with NewExporter, Export and ExportString like the built-in formats.
+func RegisterFormat(name string, factory ExporterFactory) (Format, error) {
+	key := strings.ToLower(strings.TrimSpace(name))
+	if key == "" {
+		return 0, fmt.Errorf("format name is required")
+	}
+	if factory == nil {
+		return 0, fmt.Errorf("nil factory for format: %s", name)
+	}
+	if _, err := parseBuiltinFormat(key); err == nil {
+		return 0, fmt.Errorf("format already registered: %s", name)
+	}
+
+	registryMu.Lock()
+	defer registryMu.Unlock()
+
+	if _, exists := formatIDs[key]; exists {
+		return 0, fmt.Errorf("format already registered: %s", name)
+	}
+	format := nextFormat
+	nextFormat++
+	registry[format] = registeredFormat{name: key, factory: factory}
+	formatIDs[key] = format
+	return format, nil
+}
+
+// lookupFormat returns the registered format with the given Format value
+func lookupFormat(format Format) (registeredFormat, bool) {
+	registryMu.RLock()
+	defer registryMu.RUnlock()
+	rf, ok := registry[format]
+	return rf, ok
+}
+
+// lookupFormatName returns the Format registered under the given name
+func lookupFormatName(name string) (Format, bool) {
+	registryMu.RLock()
+	defer registryMu.RUnlock()
+	format, ok := formatIDs[strings.ToLower(name)]
+	return format, ok
+}
@@ -4,7 +4,9 @@ import (
 	"bytes"
 	"fmt"
 	"io"
+	"math"
 	"regexp"
+	"strconv"
 	"strings"
 	"time"
 
@@ -35,6 +37,27 @@ func (d SQLDialect) String() string {
 	}
 }
 
+// IdentifierCase controls case folding of table and column names
+type IdentifierCase int
+
+const (
+	CasePreserve IdentifierCase = iota
+	CaseLower
+	CaseUpper
+)
+
+// String returns the string representation of the identifier case
+func (c IdentifierCase) String() string {
+	switch c {
+	case CaseLower:
+		return "lower"
+	case CaseUpper:
+		return "upper"
+	default:
+		return "preserve"
+	}
+}
+
 // SQLOptions holds SQL-specific export options
 type SQLOptions struct {
 	Options
@@ -54,20 +77,44 @@ type SQLOptions struct {
 	// BatchSize is the number of rows per INSERT statement (0 = all in one)
 	BatchSize int
 
-	// DateFormat is the format for date values
+	// DateFormat is the format for date values (empty uses Options.DateFormat,
+	// then "2006-01-02 15:04:05")
 	DateFormat string
+
+	// CopyMode emits a PostgreSQL COPY ... FROM STDIN block with tab-separated
+	// rows instead of INSERT statements
+	CopyMode bool
+
+	// IdentifierCase folds table and column names before quoting (default: CasePreserve)
+	IdentifierCase IdentifierCase
+
+	// InsertIfNotExists emits one INSERT ... SELECT ... WHERE NOT EXISTS statement
+	// per row so that re-running the script skips rows already loaded
+	InsertIfNotExists bool
+
+	// KeyColumns are the columns compared in the existence check (required with InsertIfNotExists)
+	KeyColumns []string
+
+	// SQLiteStrictTypes wraps the values of numeric and boolean columns in
+	// CAST(... AS REAL) or CAST(... AS INTEGER) for DialectSQLite, so numbers
+	// stored as text in the sheet are not kept as text. A column is numeric
+	// when every value is a number or text that parses as one, and INTEGER
+	// when those numbers are all whole, so ID columns still join as integers.
+	// CREATE TABLE uses the same types.
+	SQLiteStrictTypes bool
 }
 
 // DefaultSQLOptions returns sensible defaults for SQL export
 func DefaultSQLOptions() *SQLOptions {
 	return &SQLOptions{
-		Options:     DefaultOptions(),
-		TableName:   "exported_table",
-		Dialect:     DialectGeneric,
-		CreateTable: false,
-		DropTable:   false,
-		BatchSize:   0,
-		DateFormat:  "2006-01-02 15:04:05",
+		Options:        DefaultOptions(),
+		TableName:      "exported_table",
+		Dialect:        DialectGeneric,
+		CreateTable:    false,
+		DropTable:      false,
+		BatchSize:      0,
+		CopyMode:       false,
+		IdentifierCase: CasePreserve,
 	}
 }
 
@@ -86,7 +133,8 @@ func NewSQLExporter(opts *SQLOptions) *SQLExporter {
 
 // Export writes the table as SQL to the writer
 func (e *SQLExporter) Export(table *models.Table, w io.Writer) error {
-	headers, filter := filterColumns(table, e.opts.SelectedColumns)
+	table = e.opts.transformTable(table)
+	headers, filter := filterColumns(table, e.opts.selectedColumns())
 
 	// Write DROP TABLE if enabled
 	if e.opts.DropTable {
@@ -104,6 +152,18 @@ func (e *SQLExporter) Export(table *models.Table, w io.Writer) error {
 		}
 	}
 
+	// Write COPY block instead of INSERT statements
+	if e.opts.CopyMode {
+		return e.writeCopy(table.Rows, headers, filter, w)
+	}
+
+	casts := e.columnCasts(table, headers)
+
+	// Write conditional INSERT statements
+	if e.opts.InsertIfNotExists {
+		return e.writeInsertIfNotExists(table.Rows, headers, filter, casts, w)
+	}
+
 	// Write INSERT statements
 	if len(table.Rows) == 0 {
 		return nil
@@ -111,7 +171,7 @@ func (e *SQLExporter) Export(table *models.Table, w io.Writer) error {
 
 	if e.opts.BatchSize <= 0 {
 		// All rows in one INSERT
-		insertStmt := e.buildInsert(table.Rows, headers, filter)
+		insertStmt := e.buildInsert(table.Rows, headers, filter, casts)
 		if _, err := w.Write([]byte(insertStmt)); err != nil {
 			return err
 		}
@@ -122,7 +182,7 @@ func (e *SQLExporter) Export(table *models.Table, w io.Writer) error {
 			if end > len(table.Rows) {
 				end = len(table.Rows)
 			}
-			insertStmt := e.buildInsert(table.Rows[i:end], headers, filter)
+			insertStmt := e.buildInsert(table.Rows[i:end], headers, filter, casts)
 			if _, err := w.Write([]byte(insertStmt)); err != nil {
 				return err
 			}
@@ -147,10 +207,14 @@ func (e *SQLExporter) buildDropTable() string {
 func (e *SQLExporter) buildCreateTable(table *models.Table, headers []string) string {
 	tableName := e.escapeIdentifier(e.opts.TableName)
 
+	casts := e.columnCasts(table, headers)
 	var columns []string
 	for _, header := range headers {
-		colName := e.escapeIdentifier(header)
+		colName := e.escapeIdentifier(e.opts.outputHeader(header))
 		colType := e.inferColumnType(table, header)
+		if cast := casts[header]; cast != "" {
+			colType = cast
+		}
 		columns = append(columns, fmt.Sprintf("    %s %s", colName, colType))
 	}
 
@@ -186,6 +250,9 @@ func (e *SQLExporter) inferColumnType(table *models.Table, header string) string
 	case hasDate:
 		return e.dateType()
 	case hasBool && !hasNumber:
+		if e.textBools() {
+			return e.stringType()
+		}
 		return e.boolType()
 	case hasNumber:
 		return e.numberType()
@@ -194,6 +261,67 @@ func (e *SQLExporter) inferColumnType(table *models.Table, header string) string
 	}
 }
 
+// columnCasts returns the SQLite type each numeric or boolean column's values
+// are cast to when SQLiteStrictTypes applies, or nil otherwise
+func (e *SQLExporter) columnCasts(table *models.Table, headers []string) map[string]string {
+	if !e.opts.SQLiteStrictTypes || e.opts.Dialect != DialectSQLite {
+		return nil
+	}
+
+	casts := make(map[string]string, len(headers))
+	for _, header := range headers {
+		numeric, integral, boolean, seen := true, true, true, false
+		for _, row := range table.Rows {
+			cell, ok := row.Values[header]
+			if !ok || cell.IsEmpty() {
+				continue
+			}
+			seen = true
+			_, isBool := cell.Value.(bool)
+			boolean = boolean && isBool
+			f, isNumber := numericValue(cell)
+			numeric = numeric && (cell.Type == models.CellTypeNumber || isNumber)
+			integral = integral && isNumber && f == math.Trunc(f) && math.Abs(f) < 1<<53
+		}
+		switch {
+		case !seen:
+		case numeric && integral:
+			casts[header] = "INTEGER"
+		case numeric:
+			casts[header] = "REAL"
+		case boolean && !e.textBools():
+			casts[header] = "INTEGER"
+		}
+	}
+	return casts
+}
+
+// numericValue returns the number held by a numeric cell or by text such as
+// "12.50"
+func numericValue(cell models.Cell) (float64, bool) {
+	switch v := cell.Value.(type) {
+	case float64:
+		return v, true
+	case int:
+		return float64(v), true
+	case int64:
+		return float64(v), true
+	case string:
+		f, err := strconv.ParseFloat(strings.TrimSpace(v), 64)
+		return f, err == nil
+	}
+	return 0, false
+}
+
+// castValue wraps a formatted value in CAST(... AS castType) unless it is NULL
+// or no cast applies
+func castValue(value, castType string) string {
+	if castType == "" || value == "NULL" {
+		return value
+	}
+	return fmt.Sprintf("CAST(%s AS %s)", value, castType)
+}
+
 // Type helpers for different dialects
 func (e *SQLExporter) stringType() string {
 	switch e.opts.Dialect {
@@ -239,14 +367,20 @@ func (e *SQLExporter) boolType() string {
 	}
 }
 
+// textBools reports whether booleans are written as BoolFormat text literals.
+// PostgreSQL has a native BOOLEAN type, so BoolFormat does not apply there.
+func (e *SQLExporter) textBools() bool {
+	return e.opts.BoolFormat.isSet() && e.opts.Dialect != DialectPostgreSQL
+}
+
 // buildInsert generates an INSERT statement for the given rows
-func (e *SQLExporter) buildInsert(rows []models.Row, headers []string, filter map[string]bool) string {
+func (e *SQLExporter) buildInsert(rows []models.Row, headers []string, filter map[string]bool, casts map[string]string) string {
 	tableName := e.escapeIdentifier(e.opts.TableName)
 
 	// Build column list
 	var escapedHeaders []string
 	for _, h := range headers {
-		escapedHeaders = append(escapedHeaders, e.escapeIdentifier(h))
+		escapedHeaders = append(escapedHeaders, e.escapeIdentifier(e.opts.outputHeader(h)))
 	}
 	columnList := strings.Join(escapedHeaders, ", ")
 
@@ -258,7 +392,7 @@ func (e *SQLExporter) buildInsert(rows []models.Row, headers []string, filter ma
 			if filter[header] {
 				cell, ok := row.Values[header]
 				if ok {
-					values = append(values, e.formatValue(cell))
+					values = append(values, castValue(e.formatValue(cell), casts[header]))
 				} else {
 					values = append(values, "NULL")
 				}
@@ -271,11 +405,151 @@ func (e *SQLExporter) buildInsert(rows []models.Row, headers []string, filter ma
 		tableName, columnList, strings.Join(valueGroups, ",\n"))
 }
 
+// writeInsertIfNotExists writes one INSERT ... SELECT statement per row, guarded by
+// a NOT EXISTS check on the key columns
+func (e *SQLExporter) writeInsertIfNotExists(rows []models.Row, headers []string, filter map[string]bool, casts map[string]string, w io.Writer) error {
+	if len(e.opts.KeyColumns) == 0 {
+		return fmt.Errorf("InsertIfNotExists requires at least one key column")
+	}
+	for _, key := range e.opts.KeyColumns {
+		if !filter[key] {
+			return fmt.Errorf("key column not found: %s", key)
+		}
+	}
+
+	tableName := e.escapeIdentifier(e.opts.TableName)
+	var escapedHeaders []string
+	for _, h := range headers {
+		escapedHeaders = append(escapedHeaders, e.escapeIdentifier(e.opts.outputHeader(h)))
+	}
+	columnList := strings.Join(escapedHeaders, ", ")
+
+	// MySQL does not allow a WHERE clause on a SELECT without a FROM
+	from := ""
+	if e.opts.Dialect == DialectMySQL {
+		from = " FROM DUAL"
+	}
+
+	for i, row := range rows {
+		var values []string
+		for _, header := range headers {
+			values = append(values, castValue(e.rowValue(row, header), casts[header]))
+		}
+
+		var conditions []string
+		for _, key := range e.opts.KeyColumns {
+			column := e.escapeIdentifier(e.opts.outputHeader(key))
+			value := castValue(e.rowValue(row, key), casts[key])
+			if value == "NULL" {
+				conditions = append(conditions, column+" IS NULL")
+			} else {
+				conditions = append(conditions, column+" = "+value)
+			}
+		}
+
+		stmt := fmt.Sprintf("INSERT INTO %s (%s)\nSELECT %s%s\nWHERE NOT EXISTS (SELECT 1 FROM %s WHERE %s);",
+			tableName, columnList, strings.Join(values, ", "), from, tableName, strings.Join(conditions, " AND "))
+		if i < len(rows)-1 {
+			stmt += "\n"
+		}
+		if _, err := w.Write([]byte(stmt)); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// rowValue formats a row's value for the given header, or NULL if it is missing
+func (e *SQLExporter) rowValue(row models.Row, header string) string {
+	if cell, ok := row.Values[header]; ok {
+		return e.formatValue(cell)
+	}
+	return "NULL"
+}
+
+// writeCopy writes a COPY ... FROM STDIN header, tab-separated data rows and the
+// terminating \. line
+func (e *SQLExporter) writeCopy(rows []models.Row, headers []string, filter map[string]bool, w io.Writer) error {
+	tableName := e.escapeIdentifier(e.opts.TableName)
+
+	var escapedHeaders []string
+	for _, h := range headers {
+		escapedHeaders = append(escapedHeaders, e.escapeIdentifier(e.opts.outputHeader(h)))
+	}
+
+	var sb strings.Builder
+	sb.WriteString(fmt.Sprintf("COPY %s (%s) FROM STDIN;\n", tableName, strings.Join(escapedHeaders, ", ")))
+
+	for _, row := range rows {
+		values := make([]string, 0, len(headers))
+		for _, header := range headers {
+			if filter[header] {
+				cell, ok := row.Values[header]
+				if ok {
+					values = append(values, e.formatCopyValue(cell))
+				} else {
+					values = append(values, `\N`)
+				}
+			}
+		}
+		sb.WriteString(strings.Join(values, "\t"))
+		sb.WriteString("\n")
+	}
+
+	sb.WriteString("\\.\n")
+
+	_, err := w.Write([]byte(sb.String()))
+	return err
+}
+
+// formatCopyValue formats a cell value for the COPY text format
+func (e *SQLExporter) formatCopyValue(cell models.Cell) string {
+	if cell.IsEmpty() {
+		return `\N`
+	}
+
+	switch v := cell.Value.(type) {
+	case time.Time:
+		return escapeCopyText(e.opts.formatDate(v, e.opts.dateLayout(e.opts.DateFormat, "2006-01-02 15:04:05")))
+	case float64:
+		return fmt.Sprintf("%g", v)
+	case bool:
+		if v {
+			return "t"
+		}
+		return "f"
+	case string:
+		return escapeCopyText(v)
+	default:
+		return escapeCopyText(cell.RawValue)
+	}
+}
+
+// copyEscaper escapes characters with special meaning in the COPY text format
+var copyEscaper = strings.NewReplacer(
+	`\`, `\\`,
+	"\t", `\t`,
+	"\n", `\n`,
+	"\r", `\r`,
+)
+
+// escapeCopyText escapes a string for the COPY text format
+func escapeCopyText(s string) string {
+	return copyEscaper.Replace(s)
+}
+
 // escapeIdentifier escapes a SQL identifier (table/column name)
 func (e *SQLExporter) escapeIdentifier(name string) string {
 	// Remove any existing quotes and dangerous characters
 	clean := regexp.MustCompile(`[^\w]`).ReplaceAllString(name, "_")
 
+	switch e.opts.IdentifierCase {
+	case CaseLower:
+		clean = strings.ToLower(clean)
+	case CaseUpper:
+		clean = strings.ToUpper(clean)
+	}
+
 	switch e.opts.Dialect {
 	case DialectMySQL:
 		return "`" + clean + "`"
@@ -294,10 +568,13 @@ func (e *SQLExporter) formatValue(cell models.Cell) string {
 
 	switch v := cell.Value.(type) {
 	case time.Time:
-		return fmt.Sprintf("'%s'", v.Format(e.opts.DateFormat))
+		return fmt.Sprintf("'%s'", e.opts.formatDate(v, e.opts.dateLayout(e.opts.DateFormat, "2006-01-02 15:04:05")))
 	case float64:
 		return fmt.Sprintf("%g", v)
 	case bool:
+		if e.textBools() {
+			return e.escapeString(e.opts.BoolFormat.format(v))
+		}
 		switch e.opts.Dialect {
 		case DialectPostgreSQL:
 			if v {
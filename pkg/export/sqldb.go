@@ -0,0 +1,194 @@
+package export
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/meddhiazoghlami/goxls/pkg/models"
+)
+
+// SQLLoadOptions holds options for loading a table into a database.
+// TableName, Dialect, HeaderAliases, SelectedColumns, CreateTable and
+// DropTable work as for SQL export. BatchSize is the number of rows committed
+// per transaction (0 = all rows in one transaction).
+type SQLLoadOptions struct {
+	SQLOptions
+
+	// Upsert updates existing rows that match on KeyColumns instead of failing
+	Upsert bool
+}
+
+// DefaultSQLLoadOptions returns sensible defaults for loading into a database
+func DefaultSQLLoadOptions() SQLLoadOptions {
+	return SQLLoadOptions{SQLOptions: *DefaultSQLOptions()}
+}
+
+// LoadToDB inserts the table's rows into db using a prepared INSERT statement
+// executed once per row. Rows are committed in transactions of BatchSize rows,
+// so on error the returned count covers the batches already committed.
+func LoadToDB(ctx context.Context, table *models.Table, db *sql.DB, opts SQLLoadOptions) (inserted int, err error) {
+	if db == nil {
+		return 0, fmt.Errorf("database is nil")
+	}
+	if opts.TableName == "" {
+		return 0, fmt.Errorf("table name is required")
+	}
+	if opts.CopyMode || opts.InsertIfNotExists {
+		return 0, fmt.Errorf("CopyMode and InsertIfNotExists are not supported by LoadToDB")
+	}
+
+	e := NewSQLExporter(&opts.SQLOptions)
+	table = opts.transformTable(table)
+	headers, _ := filterColumns(table, opts.selectedColumns())
+	if len(headers) == 0 {
+		return 0, fmt.Errorf("no columns to load")
+	}
+
+	query, err := e.buildLoadQuery(headers, opts.Upsert)
+	if err != nil {
+		return 0, err
+	}
+
+	if opts.DropTable {
+		if _, err := db.ExecContext(ctx, e.buildDropTable()); err != nil {
+			return 0, err
+		}
+	}
+	if opts.CreateTable {
+		if _, err := db.ExecContext(ctx, e.buildCreateTable(table, headers)); err != nil {
+			return 0, err
+		}
+	}
+
+	batchSize := opts.BatchSize
+	if batchSize <= 0 {
+		batchSize = len(table.Rows)
+	}
+	for start := 0; start < len(table.Rows); start += batchSize {
+		end := start + batchSize
+		if end > len(table.Rows) {
+			end = len(table.Rows)
+		}
+		if err := e.loadBatch(ctx, db, query, table.Rows[start:end], headers); err != nil {
+			return inserted, err
+		}
+		inserted += end - start
+	}
+
+	return inserted, nil
+}
+
+// loadBatch executes the prepared query for each row inside one transaction
+func (e *SQLExporter) loadBatch(ctx context.Context, db *sql.DB, query string, rows []models.Row, headers []string) error {
+	tx, err := db.BeginTx(ctx, nil)
+	if err != nil {
+		return err
+	}
+
+	stmt, err := tx.PrepareContext(ctx, query)
+	if err != nil {
+		tx.Rollback()
+		return err
+	}
+	defer stmt.Close()
+
+	args := make([]interface{}, len(headers))
+	for _, row := range rows {
+		for i, header := range headers {
+			args[i] = e.dbValue(row, header)
+		}
+		if _, err := stmt.ExecContext(ctx, args...); err != nil {
+			tx.Rollback()
+			return fmt.Errorf("row %d: %w", row.Index, err)
+		}
+	}
+
+	return tx.Commit()
+}
+
+// buildLoadQuery builds a parameterized INSERT for the headers. With upsert it
+// adds the dialect's conflict clause, updating every non-key column.
+func (e *SQLExporter) buildLoadQuery(headers []string, upsert bool) (string, error) {
+	columns := make([]string, len(headers))
+	placeholders := make([]string, len(headers))
+	for i, h := range headers {
+		columns[i] = e.escapeIdentifier(e.opts.outputHeader(h))
+		placeholders[i] = "?"
+		if e.opts.Dialect == DialectPostgreSQL {
+			placeholders[i] = fmt.Sprintf("$%d", i+1)
+		}
+	}
+	query := fmt.Sprintf("INSERT INTO %s (%s) VALUES (%s)",
+		e.escapeIdentifier(e.opts.TableName), strings.Join(columns, ", "), strings.Join(placeholders, ", "))
+	if !upsert {
+		return query, nil
+	}
+
+	if len(e.opts.KeyColumns) == 0 {
+		return "", fmt.Errorf("Upsert requires at least one key column")
+	}
+	selected := make(map[string]bool, len(headers))
+	for _, h := range headers {
+		selected[h] = true
+	}
+	isKey := make(map[string]bool, len(e.opts.KeyColumns))
+	var keys []string
+	for _, key := range e.opts.KeyColumns {
+		if !selected[key] {
+			return "", fmt.Errorf("key column not found: %s", key)
+		}
+		isKey[key] = true
+		keys = append(keys, e.escapeIdentifier(e.opts.outputHeader(key)))
+	}
+
+	var updates []string
+	for i, h := range headers {
+		if isKey[h] {
+			continue
+		}
+		if e.opts.Dialect == DialectMySQL {
+			updates = append(updates, fmt.Sprintf("%s = VALUES(%s)", columns[i], columns[i]))
+		} else {
+			updates = append(updates, fmt.Sprintf("%s = excluded.%s", columns[i], columns[i]))
+		}
+	}
+
+	if e.opts.Dialect == DialectMySQL {
+		if len(updates) == 0 {
+			updates = append(updates, fmt.Sprintf("%s = %s", keys[0], keys[0]))
+		}
+		return query + " ON DUPLICATE KEY UPDATE " + strings.Join(updates, ", "), nil
+	}
+	conflict := " ON CONFLICT (" + strings.Join(keys, ", ") + ")"
+	if len(updates) == 0 {
+		return query + conflict + " DO NOTHING", nil
+	}
+	return query + conflict + " DO UPDATE SET " + strings.Join(updates, ", "), nil
+}
+
+// dbValue returns a row's value for a header as a database/sql argument
+func (e *SQLExporter) dbValue(row models.Row, header string) interface{} {
+	cell, ok := row.Values[header]
+	if !ok || cell.IsEmpty() {
+		return nil
+	}
+
+	switch v := cell.Value.(type) {
+	case time.Time:
+		return e.opts.inLocation(v)
+	case float64:
+		return v
+	case bool:
+		if e.textBools() {
+			return e.opts.BoolFormat.format(v)
+		}
+		return v
+	case string:
+		return v
+	default:
+		return cell.RawValue
+	}
+}
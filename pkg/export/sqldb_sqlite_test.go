@@ -0,0 +1,80 @@
+//go:build sqlite
+
+package export
+
+import (
+	"context"
+	"database/sql"
+	"testing"
+
+	"github.com/meddhiazoghlami/goxls/pkg/models"
+	_ "modernc.org/sqlite"
+)
+
+// Run with: go test -tags sqlite ./pkg/export
+func TestLoadToDB_SQLite(t *testing.T) {
+	db, err := sql.Open("sqlite", ":memory:")
+	if err != nil {
+		t.Fatalf("sql.Open() error = %v", err)
+	}
+	defer db.Close()
+	// Each connection would get its own in-memory database
+	db.SetMaxOpenConns(1)
+
+	ctx := context.Background()
+	if _, err := db.ExecContext(ctx, `CREATE TABLE "people" ("ID" REAL PRIMARY KEY, "Name" TEXT, "Age" REAL, "Active" INTEGER, "JoinDate" TEXT)`); err != nil {
+		t.Fatalf("CREATE TABLE error = %v", err)
+	}
+
+	opts := DefaultSQLLoadOptions()
+	opts.TableName = "people"
+	opts.Dialect = DialectSQLite
+	opts.BatchSize = 2
+
+	inserted, err := LoadToDB(ctx, createTestTable(), db, opts)
+	if err != nil {
+		t.Fatalf("LoadToDB() error = %v", err)
+	}
+	if inserted != 3 {
+		t.Errorf("LoadToDB() inserted = %d, want 3", inserted)
+	}
+
+	var count int
+	if err := db.QueryRowContext(ctx, `SELECT COUNT(*) FROM "people"`).Scan(&count); err != nil {
+		t.Fatalf("SELECT COUNT error = %v", err)
+	}
+	if count != 3 {
+		t.Errorf("row count = %d, want 3", count)
+	}
+
+	var age sql.NullFloat64
+	if err := db.QueryRowContext(ctx, `SELECT "Age" FROM "people" WHERE "ID" = 3`).Scan(&age); err != nil {
+		t.Fatalf("SELECT Age error = %v", err)
+	}
+	if age.Valid {
+		t.Errorf("empty Age = %v, want NULL", age.Float64)
+	}
+
+	// Loading again fails on the primary key unless upserting
+	if _, err := LoadToDB(ctx, createTestTable(), db, opts); err == nil {
+		t.Error("LoadToDB() with duplicate keys should return an error")
+	}
+
+	table := createTestTable()
+	table.Rows[0].Values["Name"] = models.Cell{Value: "Alicia", Type: models.CellTypeString, RawValue: "Alicia"}
+	opts.Upsert = true
+	opts.KeyColumns = []string{"ID"}
+	if _, err := LoadToDB(ctx, table, db, opts); err != nil {
+		t.Fatalf("LoadToDB() upsert error = %v", err)
+	}
+	var name string
+	if err := db.QueryRowContext(ctx, `SELECT "Name" FROM "people" WHERE "ID" = 1`).Scan(&name); err != nil {
+		t.Fatalf("SELECT Name error = %v", err)
+	}
+	if err := db.QueryRowContext(ctx, `SELECT COUNT(*) FROM "people"`).Scan(&count); err != nil {
+		t.Fatalf("SELECT COUNT error = %v", err)
+	}
+	if name != "Alicia" || count != 3 {
+		t.Errorf("after upsert Name = %q, count = %d, want Alicia, 3", name, count)
+	}
+}
@@ -0,0 +1,330 @@
+package export
+
+import (
+	"fmt"
+	"io"
+	"strings"
+	"time"
+
+	"github.com/meddhiazoghlami/goxls/pkg/models"
+	"github.com/xuri/excelize/v2"
+)
+
+// XLSXStreamWriter writes rows to a single-sheet xlsx workbook one at a time.
+// Rows are spooled by excelize's StreamWriter rather than kept in memory, so
+// very large tables can be written with roughly constant memory. The workbook
+// is written to the underlying writer when Close is called.
+type XLSXStreamWriter struct {
+	w      io.Writer
+	file   *excelize.File
+	stream *excelize.StreamWriter
+	styles xlsxStyles
+	row    int
+	closed bool
+}
+
+// NewXLSXStreamWriter creates a stream writer for a sheet named sheet
+// (default "Sheet1") and writes headers as its first row when given
+func NewXLSXStreamWriter(w io.Writer, sheet string, headers []string) (*XLSXStreamWriter, error) {
+	if w == nil {
+		return nil, fmt.Errorf("writer is nil")
+	}
+
+	file := excelize.NewFile()
+	if sheet == "" {
+		sheet = "Sheet1"
+	}
+	if sheet != "Sheet1" {
+		if err := file.SetSheetName("Sheet1", sheet); err != nil {
+			file.Close()
+			return nil, err
+		}
+	}
+
+	styles, err := newXLSXStyles(file)
+	if err != nil {
+		file.Close()
+		return nil, err
+	}
+	stream, err := file.NewStreamWriter(sheet)
+	if err != nil {
+		file.Close()
+		return nil, err
+	}
+
+	sw := &XLSXStreamWriter{w: w, file: file, stream: stream, styles: styles}
+	if len(headers) > 0 {
+		values := make([]interface{}, len(headers))
+		for i, h := range headers {
+			values[i] = h
+		}
+		if err := sw.writeRow(values); err != nil {
+			file.Close()
+			return nil, err
+		}
+	}
+	return sw, nil
+}
+
+// AddRow appends a row of cells in column order. Empty cells are left blank
+// and dates are formatted as yyyy-mm-dd, with the time of day when set.
+func (sw *XLSXStreamWriter) AddRow(cells []models.Cell) error {
+	values := make([]interface{}, len(cells))
+	for i, cell := range cells {
+		values[i] = sw.styles.value(xlsxValue(cell))
+	}
+	return sw.writeRow(values)
+}
+
+// Close flushes the sheet and writes the workbook to the underlying writer
+func (sw *XLSXStreamWriter) Close() error {
+	if sw.closed {
+		return nil
+	}
+	sw.closed = true
+	defer sw.file.Close()
+
+	if err := sw.stream.Flush(); err != nil {
+		return err
+	}
+	_, err := sw.file.WriteTo(sw.w)
+	return err
+}
+
+// writeRow writes values as the next sheet row
+func (sw *XLSXStreamWriter) writeRow(values []interface{}) error {
+	if sw.closed {
+		return fmt.Errorf("xlsx stream writer is closed")
+	}
+	cell, err := excelize.CoordinatesToCellName(1, sw.row+1)
+	if err != nil {
+		return err
+	}
+	if err := sw.stream.SetRow(cell, values); err != nil {
+		return err
+	}
+	sw.row++
+	return nil
+}
+
+// xlsxStyles holds the number formats used for dates
+type xlsxStyles struct {
+	date     int
+	dateTime int
+}
+
+// newXLSXStyles adds the date styles to a file
+func newXLSXStyles(file *excelize.File) (xlsxStyles, error) {
+	dateFormat, dateTimeFormat := "yyyy-mm-dd", "yyyy-mm-dd hh:mm:ss"
+	date, err := file.NewStyle(&excelize.Style{CustomNumFmt: &dateFormat})
+	if err != nil {
+		return xlsxStyles{}, err
+	}
+	dateTime, err := file.NewStyle(&excelize.Style{CustomNumFmt: &dateTimeFormat})
+	if err != nil {
+		return xlsxStyles{}, err
+	}
+	return xlsxStyles{date: date, dateTime: dateTime}, nil
+}
+
+// value wraps a date value with its style so it reads back as a date
+func (s xlsxStyles) value(value interface{}) interface{} {
+	t, ok := value.(time.Time)
+	if !ok {
+		return value
+	}
+	style := s.dateTime
+	if t.Hour() == 0 && t.Minute() == 0 && t.Second() == 0 {
+		style = s.date
+	}
+	return excelize.Cell{StyleID: style, Value: t}
+}
+
+// xlsxValue returns a cell's value in a form excelize writes with its type
+func xlsxValue(cell models.Cell) interface{} {
+	if cell.IsEmpty() {
+		return nil
+	}
+	switch v := cell.Value.(type) {
+	case time.Time, float64, bool, string:
+		return v
+	default:
+		return cell.RawValue
+	}
+}
+
+// XLSXOptions holds options for writing a workbook as xlsx
+type XLSXOptions struct {
+	Options
+
+	// SheetPerTable writes each table to its own sheet named after the table,
+	// instead of one sheet per source sheet holding all of its tables
+	SheetPerTable bool
+
+	// TableGap is the number of blank rows between tables on the same sheet.
+	// The default of 3 is more than DetectionConfig.MaxEmptyRows, so reading
+	// the file back finds the tables separately.
+	TableGap int
+}
+
+// DefaultXLSXOptions returns sensible defaults for xlsx export
+func DefaultXLSXOptions() XLSXOptions {
+	return XLSXOptions{
+		Options:  DefaultOptions(),
+		TableGap: 3,
+	}
+}
+
+// xlsxSheet is a sheet to write and the tables it holds
+type xlsxSheet struct {
+	name   string
+	tables []*models.Table
+}
+
+// WorkbookToXLSX writes a workbook as xlsx with headers and rows for each
+// table. By default every source sheet becomes a sheet with its tables
+// stacked top to bottom; with SheetPerTable each table gets a sheet. Sheet
+// names are made valid for Excel: at most 31 characters, without []:*?/\.
+func WorkbookToXLSX(wb *models.Workbook, w io.Writer, opts XLSXOptions) error {
+	if wb == nil {
+		return fmt.Errorf("workbook is nil")
+	}
+
+	var sheets []xlsxSheet
+	used := make(map[string]bool)
+	for i := range wb.Sheets {
+		sheet := &wb.Sheets[i]
+		if !opts.SheetPerTable {
+			s := xlsxSheet{name: xlsxSheetName(sheet.Name, used)}
+			for j := range sheet.Tables {
+				s.tables = append(s.tables, &sheet.Tables[j])
+			}
+			sheets = append(sheets, s)
+			continue
+		}
+		for j := range sheet.Tables {
+			table := &sheet.Tables[j]
+			sheets = append(sheets, xlsxSheet{name: xlsxSheetName(table.Name, used), tables: []*models.Table{table}})
+		}
+	}
+	if len(sheets) == 0 {
+		return fmt.Errorf("workbook has no sheets to write")
+	}
+
+	file := excelize.NewFile()
+	defer file.Close()
+	styles, err := newXLSXStyles(file)
+	if err != nil {
+		return err
+	}
+	for i, sheet := range sheets {
+		if i == 0 {
+			if err := file.SetSheetName("Sheet1", sheet.name); err != nil {
+				return err
+			}
+		} else if _, err := file.NewSheet(sheet.name); err != nil {
+			return err
+		}
+		if err := opts.writeSheet(file, sheet, styles); err != nil {
+			return fmt.Errorf("sheet %s: %w", sheet.name, err)
+		}
+	}
+
+	_, err = file.WriteTo(w)
+	return err
+}
+
+// writeSheet streams a sheet's tables into the file
+func (o XLSXOptions) writeSheet(file *excelize.File, sheet xlsxSheet, styles xlsxStyles) error {
+	stream, err := file.NewStreamWriter(sheet.name)
+	if err != nil {
+		return err
+	}
+
+	row := 1
+	setRow := func(values []interface{}) error {
+		cell, err := excelize.CoordinatesToCellName(1, row)
+		if err != nil {
+			return err
+		}
+		row++
+		return stream.SetRow(cell, values)
+	}
+
+	for i, table := range sheet.tables {
+		if i > 0 {
+			row += o.TableGap
+		}
+		table = o.transformTable(table)
+		headers, _ := filterColumns(table, o.selectedColumns())
+
+		if o.IncludeHeaders {
+			values := make([]interface{}, len(headers))
+			for c, name := range o.outputHeaders(headers) {
+				values[c] = name
+			}
+			if err := setRow(values); err != nil {
+				return err
+			}
+		}
+		for _, r := range table.Rows {
+			values := make([]interface{}, len(headers))
+			for c, header := range headers {
+				values[c] = styles.value(o.cellValue(r.Values[header]))
+			}
+			if err := setRow(values); err != nil {
+				return err
+			}
+		}
+	}
+	return stream.Flush()
+}
+
+// cellValue returns the value to write for a cell, applying NullValue,
+// BoolFormat and Location
+func (o XLSXOptions) cellValue(cell models.Cell) interface{} {
+	if cell.IsEmpty() {
+		if o.NullValue != "" {
+			return o.NullValue
+		}
+		return nil
+	}
+	switch v := xlsxValue(cell).(type) {
+	case bool:
+		if o.BoolFormat.isSet() {
+			return o.BoolFormat.format(v)
+		}
+		return v
+	case time.Time:
+		return o.inLocation(v)
+	default:
+		return v
+	}
+}
+
+// xlsxSheetName returns a unique sheet name valid in Excel
+func xlsxSheetName(name string, used map[string]bool) string {
+	name = strings.TrimSpace(strings.NewReplacer("[", "_", "]", "_", ":", "_", "*", "_", "?", "_", "/", "_", "\\", "_").Replace(name))
+	name = strings.Trim(name, "'")
+	if name == "" {
+		name = "Sheet"
+	}
+	name = truncateRunes(name, 31)
+
+	unique := name
+	for n := 2; used[strings.ToLower(unique)]; n++ {
+		suffix := fmt.Sprintf(" (%d)", n)
+		unique = truncateRunes(name, 31-len(suffix)) + suffix
+	}
+	used[strings.ToLower(unique)] = true
+	return unique
+}
+
+// truncateRunes shortens s to at most n runes
+func truncateRunes(s string, n int) string {
+	runes := []rune(s)
+	if len(runes) <= n {
+		return s
+	}
+	return string(runes[:n])
+}
@@ -0,0 +1,50 @@
+package export
+
+import (
+	"archive/zip"
+	"fmt"
+	"io"
+	"strings"
+
+	"github.com/meddhiazoghlami/goxls/pkg/models"
+)
+
+// ToZip writes a zip archive containing the table exported in each of the
+// given formats with default options. Entries are named after the table,
+// e.g. "Sales.csv", "Sales.json" and "Sales.sql".
+func ToZip(table *models.Table, formats []Format, w io.Writer) error {
+	zw := zip.NewWriter(w)
+	base := zipEntryBase(table)
+	seen := make(map[Format]bool, len(formats))
+
+	for _, format := range formats {
+		if seen[format] {
+			return fmt.Errorf("duplicate format in zip export: %v", format)
+		}
+		seen[format] = true
+
+		exporter, err := NewExporter(format, nil)
+		if err != nil {
+			return err
+		}
+
+		entry, err := zw.Create(base + "." + format.String())
+		if err != nil {
+			return fmt.Errorf("failed to create zip entry: %w", err)
+		}
+		if err := exporter.Export(table, entry); err != nil {
+			return fmt.Errorf("failed to write %v entry: %w", format, err)
+		}
+	}
+
+	return zw.Close()
+}
+
+// zipEntryBase returns a file-safe base name for zip entries of a table
+func zipEntryBase(table *models.Table) string {
+	name := strings.TrimSpace(table.Name)
+	if name == "" {
+		return "table"
+	}
+	return strings.NewReplacer("/", "_", "\\", "_").Replace(name)
+}
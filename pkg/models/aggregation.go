@@ -99,15 +99,7 @@ func (t *Table) GroupBy(columns ...string) *GroupedTable {
 
 // groupKey generates a unique key for a row based on group column values
 func (g *GroupedTable) groupKey(row Row) string {
-	parts := make([]string, len(g.groupCols))
-	for i, col := range g.groupCols {
-		if cell, ok := row.Get(col); ok {
-			parts[i] = cell.RawValue
-		} else {
-			parts[i] = ""
-		}
-	}
-	return strings.Join(parts, "\x00") // Use null byte as separator
+	return rowKey(row, g.groupCols)
 }
 
 // aggregator holds the state for computing an aggregation
@@ -0,0 +1,120 @@
+package models
+
+import (
+	"fmt"
+	"strconv"
+	"time"
+)
+
+// TableBuilder provides a fluent API for building tables in code.
+type TableBuilder struct {
+	name    string
+	headers []string
+	rows    [][]interface{}
+}
+
+// NewTableBuilder creates a new table builder.
+func NewTableBuilder(name string) *TableBuilder {
+	return &TableBuilder{name: name}
+}
+
+// Headers sets the column headers.
+func (b *TableBuilder) Headers(headers ...string) *TableBuilder {
+	b.headers = headers
+	return b
+}
+
+// AddRow adds a row with one value per header, in header order. Missing
+// values are empty cells and values beyond the last header are ignored.
+func (b *TableBuilder) AddRow(values ...interface{}) *TableBuilder {
+	b.rows = append(b.rows, values)
+	return b
+}
+
+// Build returns the constructed table. Cell types are inferred from the Go
+// values: strings, numbers, bools, time.Time and nil for an empty cell.
+// A Cell value is used as is apart from its position.
+func (b *TableBuilder) Build() *Table {
+	table := &Table{
+		Name:        b.name,
+		Headers:     b.headers,
+		HeaderCells: make([]Cell, len(b.headers)),
+		Rows:        make([]Row, len(b.rows)),
+		EndRow:      len(b.rows),
+		EndCol:      len(b.headers) - 1,
+	}
+	for col, h := range b.headers {
+		table.HeaderCells[col] = Cell{Value: h, Type: CellTypeString, Col: col, RawValue: h}
+	}
+
+	for i, values := range b.rows {
+		row := Row{Index: i + 1, Values: make(map[string]Cell, len(b.headers)), Cells: make([]Cell, len(b.headers))}
+		for col, h := range b.headers {
+			var value interface{}
+			if col < len(values) {
+				value = values[col]
+			}
+			cell := cellFromValue(value)
+			cell.Row, cell.Col = i+1, col
+			row.Values[h] = cell
+			row.Cells[col] = cell
+		}
+		table.Rows[i] = row
+	}
+	return table
+}
+
+// cellFromValue builds a cell whose type matches a Go value
+func cellFromValue(value interface{}) Cell {
+	switch v := value.(type) {
+	case nil:
+		return Cell{Type: CellTypeEmpty}
+	case Cell:
+		return v
+	case string:
+		if v == "" {
+			return Cell{Type: CellTypeEmpty}
+		}
+		return Cell{Value: v, Type: CellTypeString, RawValue: v}
+	case bool:
+		return Cell{Value: v, Type: CellTypeBool, RawValue: strconv.FormatBool(v)}
+	case time.Time:
+		raw := v.Format("2006-01-02 15:04:05")
+		if v.Hour() == 0 && v.Minute() == 0 && v.Second() == 0 {
+			raw = v.Format("2006-01-02")
+		}
+		return Cell{Value: v, Type: CellTypeDate, RawValue: raw}
+	case float64:
+		return numberCell(v)
+	case float32:
+		return numberCell(float64(v))
+	case int:
+		return numberCell(float64(v))
+	case int8:
+		return numberCell(float64(v))
+	case int16:
+		return numberCell(float64(v))
+	case int32:
+		return numberCell(float64(v))
+	case int64:
+		return numberCell(float64(v))
+	case uint:
+		return numberCell(float64(v))
+	case uint8:
+		return numberCell(float64(v))
+	case uint16:
+		return numberCell(float64(v))
+	case uint32:
+		return numberCell(float64(v))
+	case uint64:
+		return numberCell(float64(v))
+	default:
+		s := fmt.Sprint(v)
+		return Cell{Value: s, Type: CellTypeString, RawValue: s}
+	}
+}
+
+// numberCell builds a number cell with the shortest raw representation
+func numberCell(f float64) Cell {
+	return Cell{Value: f, Type: CellTypeNumber, RawValue: strconv.FormatFloat(f, 'f', -1, 64)}
+}
@@ -0,0 +1,52 @@
+package models
+
+import (
+	"testing"
+	"time"
+)
+
+func TestTableBuilder_Build(t *testing.T) {
+	joined := time.Date(2024, 3, 1, 0, 0, 0, 0, time.UTC)
+	table := NewTableBuilder("People").
+		Headers("Name", "Age", "Active", "Joined").
+		AddRow("Alice", 30, true, joined).
+		AddRow("Bob", 2.5, false).
+		AddRow(nil, int64(7), "", "later").
+		Build()
+
+	if table.Name != "People" || len(table.Headers) != 4 || table.RowCount() != 3 {
+		t.Fatalf("Build() = %q with headers %v and %d rows, want People, 4 headers, 3 rows", table.Name, table.Headers, table.RowCount())
+	}
+
+	tests := []struct {
+		row      int
+		column   string
+		wantType CellType
+		wantRaw  string
+	}{
+		{0, "Name", CellTypeString, "Alice"},
+		{0, "Age", CellTypeNumber, "30"},
+		{0, "Active", CellTypeBool, "true"},
+		{0, "Joined", CellTypeDate, "2024-03-01"},
+		{1, "Age", CellTypeNumber, "2.5"},
+		{1, "Joined", CellTypeEmpty, ""},
+		{2, "Name", CellTypeEmpty, ""},
+		{2, "Age", CellTypeNumber, "7"},
+		{2, "Active", CellTypeEmpty, ""},
+		{2, "Joined", CellTypeString, "later"},
+	}
+	for _, tt := range tests {
+		cell, ok := table.Rows[tt.row].Get(tt.column)
+		if !ok || cell.Type != tt.wantType || cell.RawValue != tt.wantRaw {
+			t.Errorf("row %d %s = %v %q, want %v %q", tt.row, tt.column, cell.Type, cell.RawValue, tt.wantType, tt.wantRaw)
+		}
+	}
+
+	age := table.Rows[0].Values["Age"]
+	if v, ok := age.AsFloat(); !ok || v != 30 {
+		t.Errorf("Age AsFloat() = %v, %v, want 30, true", v, ok)
+	}
+	if cell := table.Rows[1].Cells[1]; cell.Row != 2 || cell.Col != 1 {
+		t.Errorf("Cells[1] position = (%d, %d), want (2, 1)", cell.Row, cell.Col)
+	}
+}
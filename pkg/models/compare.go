@@ -0,0 +1,119 @@
+package models
+
+import (
+	"math"
+	"strings"
+)
+
+// floatEpsilon is the relative tolerance used when comparing numeric cells
+const floatEpsilon = 1e-9
+
+// Equal reports whether two cells hold the same value. Numbers are compared
+// numerically within a small relative tolerance, dates as instants and
+// booleans by value, so "1.0" equals "1" and the same date written two ways
+// is equal. Other cells compare by their string value; empty cells are equal
+// only to each other.
+func (c *Cell) Equal(other Cell) bool {
+	return c.Compare(other) == 0
+}
+
+// Compare orders two cells, returning -1, 0 or 1. Cells of different kinds
+// order by kind: empty, then numbers, dates, booleans and text. Cells of the
+// same kind compare by value as in Equal, so the order is consistent for
+// sorting mixed columns.
+func (c *Cell) Compare(other Cell) int {
+	kind, otherKind := c.kind(), other.kind()
+	if kind != otherKind {
+		if kind < otherKind {
+			return -1
+		}
+		return 1
+	}
+
+	switch kind {
+	case kindBlank:
+		return 0
+	case kindNumber:
+		a, _ := c.number()
+		b, _ := other.number()
+		return compareFloats(a, b)
+	case kindDate:
+		a, _ := c.AsTime()
+		b, _ := other.AsTime()
+		return a.Compare(b)
+	case kindBool:
+		a, b := c.Value.(bool), other.Value.(bool)
+		switch {
+		case a == b:
+			return 0
+		case !a:
+			return -1
+		default:
+			return 1
+		}
+	}
+	return strings.Compare(c.text(), other.text())
+}
+
+// Cell kinds in the order Compare sorts them
+const (
+	kindBlank = iota
+	kindNumber
+	kindDate
+	kindBool
+	kindText
+)
+
+// kind returns the kind a cell compares as
+func (c *Cell) kind() int {
+	if c.blank() {
+		return kindBlank
+	}
+	if _, ok := c.number(); ok {
+		return kindNumber
+	}
+	if _, ok := c.AsTime(); ok {
+		return kindDate
+	}
+	if _, ok := c.Value.(bool); ok {
+		return kindBool
+	}
+	return kindText
+}
+
+// blank reports whether a cell holds no value at all. Unlike IsEmpty it keeps
+// cells built with only a RawValue, which compare by that text.
+func (c *Cell) blank() bool {
+	return c.RawValue == "" && (c.Value == nil || c.Type == CellTypeEmpty)
+}
+
+// text returns a string value, or the raw text for other values
+func (c *Cell) text() string {
+	if s, ok := c.Value.(string); ok {
+		return s
+	}
+	return c.RawValue
+}
+
+// number returns the cell's numeric value, accepting integer values stored by hand
+func (c *Cell) number() (float64, bool) {
+	switch v := c.Value.(type) {
+	case int:
+		return float64(v), true
+	case int64:
+		return float64(v), true
+	}
+	return c.AsFloat()
+}
+
+// compareFloats orders two numbers, treating values within floatEpsilon of
+// each other (relative to their magnitude) as equal
+func compareFloats(a, b float64) int {
+	if math.Abs(a-b) <= floatEpsilon*math.Max(1, math.Max(math.Abs(a), math.Abs(b))) {
+		return 0
+	}
+	if a < b {
+		return -1
+	}
+	return 1
+}
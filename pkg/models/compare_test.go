@@ -0,0 +1,115 @@
+package models
+
+import (
+	"testing"
+	"time"
+)
+
+func TestCell_Equal(t *testing.T) {
+	jan15 := time.Date(2023, 1, 15, 0, 0, 0, 0, time.UTC)
+
+	tests := []struct {
+		name string
+		a, b Cell
+		want bool
+	}{
+		{"1.0 equals 1", Cell{Value: 1.0, Type: CellTypeNumber, RawValue: "1.0"}, Cell{Value: float64(1), Type: CellTypeNumber, RawValue: "1"}, true},
+		{"int equals float", Cell{Value: 2, Type: CellTypeNumber, RawValue: "2"}, Cell{Value: 2.0, Type: CellTypeNumber, RawValue: "2.00"}, true},
+		{"float rounding", Cell{Value: 0.1 + 0.2, Type: CellTypeNumber, RawValue: "0.3"}, Cell{Value: 0.3, Type: CellTypeNumber, RawValue: "0.3"}, true},
+		{"different numbers", Cell{Value: 1.0, Type: CellTypeNumber, RawValue: "1"}, Cell{Value: 1.001, Type: CellTypeNumber, RawValue: "1.001"}, false},
+		{"same date, different text", Cell{Value: jan15, Type: CellTypeDate, RawValue: "2023-01-15"}, Cell{Value: jan15, Type: CellTypeDate, RawValue: "01/15/2023"}, true},
+		{"same instant, different zone", Cell{Value: jan15, Type: CellTypeDate, RawValue: "2023-01-15"}, Cell{Value: jan15.In(time.FixedZone("EST", -5*3600)), Type: CellTypeDate, RawValue: "2023-01-14 19:00"}, true},
+		{"different dates", Cell{Value: jan15, Type: CellTypeDate, RawValue: "2023-01-15"}, Cell{Value: jan15.AddDate(0, 0, 1), Type: CellTypeDate, RawValue: "2023-01-16"}, false},
+		{"bools", Cell{Value: true, Type: CellTypeBool, RawValue: "TRUE"}, Cell{Value: true, Type: CellTypeBool, RawValue: "true"}, true},
+		{"strings", Cell{Value: "a", Type: CellTypeString, RawValue: "a"}, Cell{Value: "a", Type: CellTypeString, RawValue: "a"}, true},
+		{"strings differ by case", Cell{Value: "a", Type: CellTypeString, RawValue: "a"}, Cell{Value: "A", Type: CellTypeString, RawValue: "A"}, false},
+		{"both empty", Cell{Type: CellTypeEmpty}, Cell{}, true},
+		{"empty and value", Cell{Type: CellTypeEmpty}, Cell{Value: "x", Type: CellTypeString, RawValue: "x"}, false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.a.Equal(tt.b); got != tt.want {
+				t.Errorf("Equal() = %v, want %v", got, tt.want)
+			}
+			if got := tt.b.Equal(tt.a); got != tt.want {
+				t.Errorf("reversed Equal() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestCell_Compare(t *testing.T) {
+	jan15 := time.Date(2023, 1, 15, 0, 0, 0, 0, time.UTC)
+
+	tests := []struct {
+		name string
+		a, b Cell
+		want int
+	}{
+		{"numbers, not text order", Cell{Value: 9.0, Type: CellTypeNumber, RawValue: "9"}, Cell{Value: 10.0, Type: CellTypeNumber, RawValue: "10"}, -1},
+		{"dates", Cell{Value: jan15.AddDate(0, 1, 0), Type: CellTypeDate, RawValue: "2023-02-15"}, Cell{Value: jan15, Type: CellTypeDate, RawValue: "2023-01-15"}, 1},
+		{"bools", Cell{Value: false, Type: CellTypeBool, RawValue: "false"}, Cell{Value: true, Type: CellTypeBool, RawValue: "true"}, -1},
+		{"strings", Cell{Value: "apple", Type: CellTypeString, RawValue: "apple"}, Cell{Value: "banana", Type: CellTypeString, RawValue: "banana"}, -1},
+		{"empty first", Cell{Type: CellTypeEmpty}, Cell{Value: 1.0, Type: CellTypeNumber, RawValue: "1"}, -1},
+		{"equal numbers", Cell{Value: 1.0, Type: CellTypeNumber, RawValue: "1.0"}, Cell{Value: 1.0, Type: CellTypeNumber, RawValue: "1"}, 0},
+		{"number before text", Cell{Value: 10.0, Type: CellTypeNumber, RawValue: "10"}, Cell{Value: "1", Type: CellTypeString, RawValue: "1"}, -1},
+		{"number before date", Cell{Value: 1e9, Type: CellTypeNumber, RawValue: "1e9"}, Cell{Value: jan15, Type: CellTypeDate, RawValue: "2023-01-15"}, -1},
+		{"date before bool", Cell{Value: jan15, Type: CellTypeDate, RawValue: "2023-01-15"}, Cell{Value: false, Type: CellTypeBool, RawValue: "false"}, -1},
+		{"bool before text", Cell{Value: true, Type: CellTypeBool, RawValue: "true"}, Cell{Value: "a", Type: CellTypeString, RawValue: "a"}, -1},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.a.Compare(tt.b); got != tt.want {
+				t.Errorf("Compare() = %d, want %d", got, tt.want)
+			}
+			if got := tt.b.Compare(tt.a); got != -tt.want {
+				t.Errorf("reversed Compare() = %d, want %d", got, -tt.want)
+			}
+		})
+	}
+}
+
+func TestCell_Compare_MixedKindsTransitive(t *testing.T) {
+	// By text "10" < "9" < "a", yet 9 < 10 by number; ordering kinds first
+	// keeps the order consistent
+	cells := []Cell{
+		{Value: "9", Type: CellTypeString, RawValue: "9"},
+		{Value: 10.0, Type: CellTypeNumber, RawValue: "10"},
+		{Value: 9.0, Type: CellTypeNumber, RawValue: "9"},
+		{Value: "a", Type: CellTypeString, RawValue: "a"},
+	}
+	for _, a := range cells {
+		for _, b := range cells {
+			for _, c := range cells {
+				if a.Compare(b) < 0 && b.Compare(c) < 0 && a.Compare(c) >= 0 {
+					t.Errorf("%q < %q < %q but Compare(%q, %q) = %d", a.RawValue, b.RawValue, c.RawValue, a.RawValue, c.RawValue, a.Compare(c))
+				}
+			}
+		}
+	}
+}
+
+func TestDiffTables_TypedComparison(t *testing.T) {
+	jan15 := time.Date(2023, 1, 15, 0, 0, 0, 0, time.UTC)
+	oldTable := NewTableBuilder("Old").
+		Headers("ID", "Amount", "Date").
+		AddRow("A", Cell{Value: 1.0, Type: CellTypeNumber, RawValue: "1.0"}, Cell{Value: jan15, Type: CellTypeDate, RawValue: "2023-01-15"}).
+		AddRow("B", 5, jan15).
+		Build()
+	newTable := NewTableBuilder("New").
+		Headers("ID", "Amount", "Date").
+		AddRow("A", 1, Cell{Value: jan15, Type: CellTypeDate, RawValue: "15/01/2023"}).
+		AddRow("B", 6, jan15).
+		Build()
+
+	result := DiffTables(oldTable, newTable, "ID")
+	if len(result.ModifiedRows) != 1 {
+		t.Fatalf("ModifiedRows = %d, want 1", len(result.ModifiedRows))
+	}
+	diff := result.ModifiedRows[0]
+	if diff.KeyValue != "B" || len(diff.Changes) != 1 || diff.Changes[0].Column != "Amount" {
+		t.Errorf("ModifiedRows[0] = %+v, want only B's Amount to change", diff)
+	}
+}
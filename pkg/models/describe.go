@@ -0,0 +1,177 @@
+package models
+
+import (
+	"fmt"
+	"strings"
+	"text/tabwriter"
+)
+
+// TableSummary is a one-call profile of a table, like pandas' describe()
+type TableSummary struct {
+	Name        string
+	RowCount    int
+	ColumnCount int
+	Columns     []ColumnSummary
+}
+
+// ColumnSummary profiles a single column of a TableSummary
+type ColumnSummary struct {
+	Name            string
+	Type            CellType // Most common non-empty cell type
+	NullCount       int      // Number of empty or missing values
+	UniqueCount     int      // Number of distinct non-empty values
+	HasNumericStats bool     // True if Min, Max and Mean are valid
+	Min             float64
+	Max             float64
+	Mean            float64
+}
+
+// Describe summarizes the table's size and, per column, its inferred type,
+// null and unique counts and numeric min/max/mean
+func (t *Table) Describe() *TableSummary {
+	summary := &TableSummary{
+		Name:        t.Name,
+		RowCount:    t.RowCount(),
+		ColumnCount: t.ColCount(),
+	}
+	for _, stats := range t.AnalyzeColumns() {
+		summary.Columns = append(summary.Columns, ColumnSummary{
+			Name:            stats.Name,
+			Type:            stats.InferredType,
+			NullCount:       stats.NullCount,
+			UniqueCount:     stats.UniqueCount,
+			HasNumericStats: stats.HasNumericStats,
+			Min:             stats.Min,
+			Max:             stats.Max,
+			Mean:            stats.Avg,
+		})
+	}
+	return summary
+}
+
+// NullCount returns the number of empty or missing cells in a column. It is 0
+// for a column the table does not have.
+func (t *Table) NullCount(column string) int {
+	if !t.hasHeader(column) {
+		return 0
+	}
+	count := 0
+	for _, row := range t.Rows {
+		if cell, ok := row.Values[column]; !ok || cell.IsEmpty() {
+			count++
+		}
+	}
+	return count
+}
+
+// Completeness returns the fraction of non-empty cells in each column, from
+// 0 to 1. Columns of a table without rows are complete.
+func (t *Table) Completeness() map[string]float64 {
+	result := make(map[string]float64, len(t.Headers))
+	for _, h := range t.Headers {
+		result[h] = 1
+		if len(t.Rows) > 0 {
+			result[h] = 1 - float64(t.NullCount(h))/float64(len(t.Rows))
+		}
+	}
+	return result
+}
+
+// OverallCompleteness returns the fraction of non-empty cells across all
+// columns. A table without rows or columns is complete.
+func (t *Table) OverallCompleteness() float64 {
+	total := len(t.Rows) * len(t.Headers)
+	if total == 0 {
+		return 1
+	}
+	nulls := 0
+	for _, h := range t.Headers {
+		nulls += t.NullCount(h)
+	}
+	return 1 - float64(nulls)/float64(total)
+}
+
+// CandidateKeys suggests columns that could identify rows: every column whose
+// values are present and distinct in all rows, followed by pairs of other
+// columns that are unique together. No pair includes a column that is a key
+// on its own. A table without rows has no candidate keys.
+func (t *Table) CandidateKeys() [][]string {
+	if len(t.Rows) == 0 {
+		return nil
+	}
+
+	var keys [][]string
+	isKey := make(map[string]bool)
+	for _, h := range t.Headers {
+		if t.uniqueTogether(h) {
+			keys = append(keys, []string{h})
+			isKey[h] = true
+		}
+	}
+	for i, a := range t.Headers {
+		for _, b := range t.Headers[i+1:] {
+			if !isKey[a] && !isKey[b] && t.uniqueTogether(a, b) {
+				keys = append(keys, []string{a, b})
+			}
+		}
+	}
+	return keys
+}
+
+// uniqueTogether reports whether every row has a value in each of the columns
+// and no two rows share the same combination of values
+func (t *Table) uniqueTogether(columns ...string) bool {
+	seen := make(map[string]bool, len(t.Rows))
+	parts := make([]string, len(columns))
+	for _, row := range t.Rows {
+		for i, col := range columns {
+			cell, ok := row.Values[col]
+			if !ok || cell.IsEmpty() {
+				return false
+			}
+			parts[i] = cell.RawValue
+		}
+		key := strings.Join(parts, "\x00")
+		if seen[key] {
+			return false
+		}
+		seen[key] = true
+	}
+	return true
+}
+
+// String renders the summary as an aligned text table
+func (s *TableSummary) String() string {
+	var b strings.Builder
+	fmt.Fprintf(&b, "%s: %d rows x %d columns\n", s.Name, s.RowCount, s.ColumnCount)
+
+	w := tabwriter.NewWriter(&b, 0, 0, 2, ' ', 0)
+	fmt.Fprintln(w, "column\ttype\tnulls\tunique\tmin\tmax\tmean")
+	for _, c := range s.Columns {
+		min, max, mean := "-", "-", "-"
+		if c.HasNumericStats {
+			min, max, mean = fmt.Sprintf("%g", c.Min), fmt.Sprintf("%g", c.Max), fmt.Sprintf("%.4g", c.Mean)
+		}
+		fmt.Fprintf(w, "%s\t%s\t%d\t%d\t%s\t%s\t%s\n", c.Name, cellTypeName(c.Type), c.NullCount, c.UniqueCount, min, max, mean)
+	}
+	w.Flush()
+	return b.String()
+}
+
+// cellTypeName returns a lowercase name for a cell type
+func cellTypeName(ct CellType) string {
+	switch ct {
+	case CellTypeString:
+		return "string"
+	case CellTypeNumber:
+		return "number"
+	case CellTypeDate:
+		return "date"
+	case CellTypeBool:
+		return "bool"
+	case CellTypeFormula:
+		return "formula"
+	default:
+		return "empty"
+	}
+}
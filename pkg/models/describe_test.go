@@ -0,0 +1,122 @@
+package models
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestTable_Describe(t *testing.T) {
+	table := NewTableBuilder("People").
+		Headers("Name", "Age", "Active").
+		AddRow("Alice", 30, true).
+		AddRow("Bob", 20, nil).
+		AddRow("Alice", "unknown", false).
+		AddRow(nil, 40, true).
+		Build()
+
+	summary := table.Describe()
+
+	if summary.Name != "People" || summary.RowCount != 4 || summary.ColumnCount != 3 {
+		t.Fatalf("Describe() = %q %dx%d, want People 4x3", summary.Name, summary.RowCount, summary.ColumnCount)
+	}
+	if len(summary.Columns) != 3 {
+		t.Fatalf("len(Columns) = %d, want 3", len(summary.Columns))
+	}
+
+	name, age, active := summary.Columns[0], summary.Columns[1], summary.Columns[2]
+	if name.Type != CellTypeString || name.NullCount != 1 || name.UniqueCount != 2 || name.HasNumericStats {
+		t.Errorf("Name summary = %+v, want string, 1 null, 2 unique, no numeric stats", name)
+	}
+	if age.Type != CellTypeNumber || age.NullCount != 0 || age.UniqueCount != 4 {
+		t.Errorf("Age summary = %+v, want number, 0 nulls, 4 unique", age)
+	}
+	if !age.HasNumericStats || age.Min != 20 || age.Max != 40 || age.Mean != 30 {
+		t.Errorf("Age stats = min %v max %v mean %v, want 20, 40, 30", age.Min, age.Max, age.Mean)
+	}
+	if active.Type != CellTypeBool || active.NullCount != 1 {
+		t.Errorf("Active summary = %+v, want bool with 1 null", active)
+	}
+
+	out := summary.String()
+	for _, want := range []string{"People: 4 rows x 3 columns", "column", "Age", "number", "20", "40", "Name", "string"} {
+		if !strings.Contains(out, want) {
+			t.Errorf("String() missing %q:\n%s", want, out)
+		}
+	}
+}
+
+func TestTable_Completeness(t *testing.T) {
+	table := NewTableBuilder("People").
+		Headers("Name", "Email").
+		AddRow("Alice", "alice@example.com").
+		AddRow("Bob", nil).
+		AddRow("Charlie", "charlie@example.com").
+		AddRow("Dana", "dana@example.com").
+		Build()
+
+	if got := table.NullCount("Email"); got != 1 {
+		t.Errorf("NullCount(Email) = %d, want 1", got)
+	}
+	if got := table.NullCount("Phone"); got != 0 {
+		t.Errorf("NullCount(Phone) = %d, want 0", got)
+	}
+
+	completeness := table.Completeness()
+	if completeness["Name"] != 1 || completeness["Email"] != 0.75 {
+		t.Errorf("Completeness() = %v, want Name 1 and Email 0.75", completeness)
+	}
+	if got := table.OverallCompleteness(); got != 0.875 {
+		t.Errorf("OverallCompleteness() = %v, want 0.875", got)
+	}
+
+	empty := NewTableBuilder("Empty").Headers("A").Build()
+	if empty.Completeness()["A"] != 1 || empty.OverallCompleteness() != 1 {
+		t.Errorf("empty table completeness = %v, %v, want 1", empty.Completeness(), empty.OverallCompleteness())
+	}
+}
+
+func TestTable_UniqueAndConstantColumns(t *testing.T) {
+	table := NewTableBuilder("Orders").
+		Headers("ID", "Region", "Customer", "Month", "Note").
+		AddRow(1, "EU", "Alice", "Jan", nil).
+		AddRow(2, "EU", "Alice", "Feb", "late").
+		AddRow(3, "EU", "Bob", "Jan", nil).
+		AddRow(4, "EU", "Bob", "Feb", "rush").
+		Build()
+
+	stats := make(map[string]ColumnStats)
+	for _, s := range table.AnalyzeColumns() {
+		stats[s.Name] = s
+	}
+	tests := []struct {
+		column             string
+		unique, isConstant bool
+	}{
+		{"ID", true, false},
+		{"Region", false, true},
+		{"Customer", false, false},
+		{"Note", true, false}, // unique among the rows that have one
+	}
+	for _, tt := range tests {
+		s := stats[tt.column]
+		if s.IsUnique != tt.unique || s.IsConstant != tt.isConstant {
+			t.Errorf("%s IsUnique = %v, IsConstant = %v, want %v, %v", tt.column, s.IsUnique, s.IsConstant, tt.unique, tt.isConstant)
+		}
+	}
+
+	keys := table.CandidateKeys()
+	want := [][]string{{"ID"}, {"Customer", "Month"}}
+	if len(keys) != len(want) {
+		t.Fatalf("CandidateKeys() = %v, want %v", keys, want)
+	}
+	for i := range want {
+		if strings.Join(keys[i], ",") != strings.Join(want[i], ",") {
+			t.Errorf("CandidateKeys()[%d] = %v, want %v", i, keys[i], want[i])
+		}
+	}
+
+	empty := NewTableBuilder("Empty").Headers("A").Build()
+	if keys := empty.CandidateKeys(); keys != nil {
+		t.Errorf("empty table CandidateKeys() = %v, want none", keys)
+	}
+}
@@ -27,6 +27,15 @@
 //	    CellTypeFormula // Formula (extracted as string)
 //	)
 //
+// # Building Tables
+//
+// TableBuilder creates tables in code, inferring cell types from Go values:
+//
+//	table := models.NewTableBuilder("People").
+//	    Headers("Name", "Age").
+//	    AddRow("Alice", 30).
+//	    Build()
+//
 // # Table Operations
 //
 // Tables support various operations:
@@ -41,10 +50,28 @@
 //	    return false
 //	})
 //
+//	// Ordering and queries
+//	sorted := table.SortBy("Age", true)
+//	top, err := table.Query().
+//	    Where("Age", ">", 18).
+//	    Where("Name", "startsWith", "A").
+//	    Select("Name", "Age").
+//	    OrderBy("Age", false).
+//	    Limit(10).
+//	    Result()
+//
 //	// Column transformations
 //	selected := table.Select("Name", "Email")
+//	fuzzy, err := table.SelectFuzzy("name", "e-mail") // matches "Full Name", "E-mail Address"
 //	renamed := table.Rename(map[string]string{"old": "new"})
+//	named, err := table.WithHeaders([]string{"ID", "Name", "Email"})
 //	reordered := table.Reorder("Email", "Name")
+//	trimmed := table.DropColumns("Notes", "Internal")
+//	filled := table.FillDown("Category") // blanks take the value above
+//	cleaned := table.ReplaceAllValues(map[string]string{"N/A": ""})
+//	enriched := orders.Enrich(customers, "CustomerID", "Name") // lookup columns by key
+//	phones := table.Coalesce("Phone", "Phone1", "Phone2") // first non-empty source per row
+//	long := table.Unpivot([]string{"Name"}, []string{"Jan", "Feb"}, "Month", "Value") // wide to long
 //
 //	// Deduplication
 //	unique := table.Deduplicate("Email")
@@ -52,6 +79,12 @@
 //
 //	// Column analysis
 //	stats := table.AnalyzeColumns()
+//	fmt.Print(table.Describe()) // types, nulls, uniques and min/max/mean per column
+//	filled := table.Completeness()["Email"] // fraction of non-empty cells, e.g. 0.75
+//	keys := table.CandidateKeys() // e.g. [[ID] [Customer Month]]
+//
+//	// Plain string records, e.g. for csv.Writer.WriteAll
+//	records := table.ToRecords(true, "")
 //
 // # Table Comparison
 //
@@ -63,6 +96,10 @@
 //	        len(diff.AddedRows), len(diff.RemovedRows), len(diff.ModifiedRows))
 //	}
 //
+// Cells are compared with Cell.Equal, so 1.0 and 1 or the same date written
+// in two formats are not reported as changes. Cell.Compare orders cells by
+// type-aware value.
+//
 // # Configuration
 //
 // Use DetectionConfig to customize table detection:
@@ -0,0 +1,52 @@
+package models
+
+import "regexp"
+
+// Column formats reported in ColumnStats.DetectedFormat
+const (
+	FormatEmail     = "email"
+	FormatURL       = "url"
+	FormatUUID      = "uuid"
+	FormatPhone     = "phone"
+	FormatISODate   = "iso_date"
+	FormatIntegerID = "integer_id"
+)
+
+// formatPattern pairs a format name with the regex that recognizes it
+type formatPattern struct {
+	name    string
+	pattern *regexp.Regexp
+}
+
+// knownFormats lists the recognized formats in priority order.
+// When several formats pass the threshold, the first one wins.
+var knownFormats = []formatPattern{
+	{FormatUUID, regexp.MustCompile(`^[0-9a-fA-F]{8}-[0-9a-fA-F]{4}-[0-9a-fA-F]{4}-[0-9a-fA-F]{4}-[0-9a-fA-F]{12}$`)},
+	{FormatEmail, regexp.MustCompile(`^[A-Za-z0-9._%+\-]+@[A-Za-z0-9.\-]+\.[A-Za-z]{2,}$`)},
+	{FormatURL, regexp.MustCompile(`^(?i)https?://[^\s/$.?#].[^\s]*$`)},
+	{FormatISODate, regexp.MustCompile(`^\d{4}-\d{2}-\d{2}([T ]\d{2}:\d{2}(:\d{2}(\.\d+)?)?(Z|[+\-]\d{2}:?\d{2})?)?$`)},
+	{FormatIntegerID, regexp.MustCompile(`^\d+$`)},
+	{FormatPhone, regexp.MustCompile(`^\+?(\d[\s\-.()]*){7,15}$`)},
+}
+
+// matchFormats increments counts for every known format the value matches
+func matchFormats(value string, counts []int) {
+	for i, f := range knownFormats {
+		if f.pattern.MatchString(value) {
+			counts[i]++
+		}
+	}
+}
+
+// detectFormat returns the first format whose match rate reaches the threshold
+func detectFormat(counts []int, nonEmpty int, threshold float64) string {
+	if nonEmpty == 0 || threshold <= 0 {
+		return ""
+	}
+	for i, f := range knownFormats {
+		if float64(counts[i])/float64(nonEmpty) >= threshold {
+			return f.name
+		}
+	}
+	return ""
+}
@@ -1,7 +1,11 @@
 package models
 
 import (
+	"fmt"
 	"math"
+	"sort"
+	"strconv"
+	"strings"
 	"time"
 )
 
@@ -41,6 +45,7 @@ type Cell struct {
 	HasComment   bool        // true if the cell has a comment
 	Hyperlink    string      // Cell hyperlink URL (if any)
 	HasHyperlink bool        // true if the cell has a hyperlink
+	NumberFormat string      // Number format code applied in Excel (e.g., "0.00%"), empty for General
 }
 
 // IsEmpty returns true if the cell is empty
@@ -61,14 +66,77 @@ func (c *Cell) AsString() string {
 	}
 }
 
-// AsFloat returns the cell value as a float64
+// AsFloat returns the cell value as a float64. A percentage-formatted cell
+// holding display text such as "50%" yields 0.5.
 func (c *Cell) AsFloat() (float64, bool) {
 	if v, ok := c.Value.(float64); ok {
 		return v, true
 	}
+	if s, ok := c.Value.(string); ok && strings.Contains(c.NumberFormat, "%") {
+		s = strings.TrimSpace(s)
+		if strings.HasSuffix(s, "%") {
+			if v, err := strconv.ParseFloat(strings.ReplaceAll(strings.TrimSuffix(s, "%"), ",", ""), 64); err == nil {
+				return v / 100, true
+			}
+		}
+	}
 	return 0, false
 }
 
+// AsCurrency returns the cell value and the currency symbol from its number
+// format; false if the cell is not numeric or not currency-formatted
+func (c *Cell) AsCurrency() (float64, string, bool) {
+	symbol := CurrencySymbol(c.NumberFormat)
+	if symbol == "" {
+		return 0, "", false
+	}
+	v, ok := c.Value.(float64)
+	if !ok {
+		return 0, "", false
+	}
+	return v, symbol, true
+}
+
+// currencySymbols are the symbols recognised in number format codes
+var currencySymbols = []string{"$", "€", "£", "¥", "₹", "₩", "₽", "₺", "₪"}
+
+// CurrencySymbol returns the currency symbol used by a number format code, or ""
+// if the format is not a currency format. Both locale tags such as "[$€-407]"
+// and literal symbols such as "$#,##0.00" or "\"£\"#,##0" are recognised.
+func CurrencySymbol(format string) string {
+	// Bracketed sections hold locale tags ([$€-407]), colours and conditions;
+	// only a [$<symbol>-<lcid>] tag names a currency
+	var literal strings.Builder
+	for format != "" {
+		open := strings.Index(format, "[")
+		if open < 0 {
+			literal.WriteString(format)
+			break
+		}
+		literal.WriteString(format[:open])
+		end := strings.Index(format[open:], "]")
+		if end < 0 {
+			break
+		}
+		section := format[open+1 : open+end]
+		if strings.HasPrefix(section, "$") {
+			symbol := strings.SplitN(section[1:], "-", 2)[0]
+			if symbol != "" {
+				return symbol
+			}
+		}
+		format = format[open+end+1:]
+	}
+
+	text := literal.String()
+	for _, symbol := range currencySymbols {
+		if strings.Contains(text, symbol) {
+			return symbol
+		}
+	}
+	return ""
+}
+
 // AsTime returns the cell value as a time.Time
 func (c *Cell) AsTime() (time.Time, bool) {
 	if v, ok := c.Value.(time.Time); ok {
@@ -77,6 +145,31 @@ func (c *Cell) AsTime() (time.Time, bool) {
 	return time.Time{}, false
 }
 
+// AsJSON returns the cell value as its natural JSON value: float64 for numbers,
+// bool for booleans, an RFC3339 string for dates, nil for empty cells and
+// string otherwise
+func (c *Cell) AsJSON() interface{} {
+	if c.IsEmpty() {
+		return nil
+	}
+	switch v := c.Value.(type) {
+	case float64:
+		return v
+	case int:
+		return float64(v)
+	case int64:
+		return float64(v)
+	case bool:
+		return v
+	case time.Time:
+		return v.Format(time.RFC3339)
+	case string:
+		return v
+	default:
+		return c.RawValue
+	}
+}
+
 // IsMergeOrigin returns true if this cell is the top-left origin of a merged region
 func (c *Cell) IsMergeOrigin() bool {
 	return c.MergeRange != nil && c.MergeRange.IsOrigin
@@ -89,22 +182,86 @@ type Row struct {
 	Cells  []Cell
 }
 
+// NewRow creates an empty row with the given index, ready for Set
+func NewRow(index int) Row {
+	return Row{Index: index, Values: make(map[string]Cell)}
+}
+
 // Get returns the cell value for a given header
 func (r *Row) Get(header string) (Cell, bool) {
 	cell, ok := r.Values[header]
 	return cell, ok
 }
 
+// Set stores the cell for a header in Values. Cells is left unchanged.
+func (r *Row) Set(header string, cell Cell) {
+	if r.Values == nil {
+		r.Values = make(map[string]Cell)
+	}
+	r.Values[header] = cell
+}
+
+// Clone returns a copy of the row whose Values map and Cells slice are
+// independent of the original
+func (r Row) Clone() Row {
+	values := make(map[string]Cell, len(r.Values))
+	for k, v := range r.Values {
+		values[k] = v
+	}
+	var cells []Cell
+	if r.Cells != nil {
+		cells = make([]Cell, len(r.Cells))
+		copy(cells, r.Cells)
+	}
+	return Row{Index: r.Index, Values: values, Cells: cells}
+}
+
 // Table represents a detected table within a sheet
 type Table struct {
-	Name       string
-	Headers    []string
-	Rows       []Row
-	StartRow   int
-	EndRow     int
-	StartCol   int
-	EndCol     int
-	HeaderRow  int
+	Name        string
+	Headers     []string
+	HeaderCells []Cell // Source cell of each header, parallel to Headers
+	Rows        []Row
+	StartRow    int
+	EndRow      int
+	StartCol    int
+	EndCol      int
+	HeaderRow   int
+	Transposed  bool   // True if headers were read down the first column (StartCol)
+	Title       string // Caption found above the header row when CaptureTitles is set
+}
+
+// HeaderCell returns the source cell of the named header
+func (t *Table) HeaderCell(name string) (Cell, bool) {
+	for i, h := range t.Headers {
+		if h == name && i < len(t.HeaderCells) {
+			return t.HeaderCells[i], true
+		}
+	}
+	return Cell{}, false
+}
+
+// hasHeader reports whether the table has a column with the given header
+func (t *Table) hasHeader(name string) bool {
+	for _, h := range t.Headers {
+		if h == name {
+			return true
+		}
+	}
+	return false
+}
+
+// headerCellsFor returns the header cells matching the given subset of headers
+func (t *Table) headerCellsFor(headers []string) []Cell {
+	if len(t.HeaderCells) == 0 {
+		return nil
+	}
+	cells := make([]Cell, 0, len(headers))
+	for _, h := range headers {
+		cell, _ := t.HeaderCell(h)
+		cells = append(cells, cell)
+	}
+	return cells
 }
 
 // RowCount returns the number of data rows (excluding header)
@@ -117,20 +274,83 @@ func (t *Table) ColCount() int {
 	return len(t.Headers)
 }
 
+// ColumnValues returns the cells of a column in row order, or nil if the column
+// does not exist. Rows without the cell get an empty Cell so the slice aligns with Rows.
+func (t *Table) ColumnValues(header string) []Cell {
+	found := false
+	for _, h := range t.Headers {
+		if h == header {
+			found = true
+			break
+		}
+	}
+	if !found {
+		return nil
+	}
+
+	cells := make([]Cell, len(t.Rows))
+	for i, row := range t.Rows {
+		if cell, ok := row.Values[header]; ok {
+			cells[i] = cell
+		}
+	}
+	return cells
+}
+
+// ColumnStrings returns a column's values as strings in row order, or nil if the
+// column does not exist. Missing cells become empty strings.
+func (t *Table) ColumnStrings(header string) []string {
+	cells := t.ColumnValues(header)
+	if cells == nil {
+		return nil
+	}
+	values := make([]string, len(cells))
+	for i := range cells {
+		values[i] = cells[i].AsString()
+	}
+	return values
+}
+
+// ToRecords returns the table as string records in header order, as used by
+// encoding/csv and similar libraries. Values come from AsString; empty and
+// missing cells become nullValue. With includeHeader the first record is the headers.
+func (t *Table) ToRecords(includeHeader bool, nullValue string) [][]string {
+	records := make([][]string, 0, len(t.Rows)+1)
+	if includeHeader {
+		records = append(records, append([]string(nil), t.Headers...))
+	}
+	for _, row := range t.Rows {
+		record := make([]string, len(t.Headers))
+		for i, header := range t.Headers {
+			cell, ok := row.Values[header]
+			if !ok || cell.IsEmpty() {
+				record[i] = nullValue
+				continue
+			}
+			record[i] = cell.AsString()
+		}
+		records = append(records, record)
+	}
+	return records
+}
+
 // RowPredicate is a function that evaluates a row and returns true if it matches
 type RowPredicate func(row Row) bool
 
 // Filter returns a new table containing only rows that match the predicate
 func (t *Table) Filter(predicate RowPredicate) *Table {
 	filtered := &Table{
-		Name:      t.Name,
-		Headers:   t.Headers,
-		Rows:      make([]Row, 0),
-		StartRow:  t.StartRow,
-		EndRow:    t.EndRow,
-		StartCol:  t.StartCol,
-		EndCol:    t.EndCol,
-		HeaderRow: t.HeaderRow,
+		Name:        t.Name,
+		Headers:     t.Headers,
+		HeaderCells: t.HeaderCells,
+		Rows:        make([]Row, 0),
+		StartRow:    t.StartRow,
+		EndRow:      t.EndRow,
+		StartCol:    t.StartCol,
+		EndCol:      t.EndCol,
+		HeaderRow:   t.HeaderRow,
+		Transposed:  t.Transposed,
+		Title:       t.Title,
 	}
 
 	for _, row := range t.Rows {
@@ -142,6 +362,77 @@ func (t *Table) Filter(predicate RowPredicate) *Table {
 	return filtered
 }
 
+// Head returns a new table containing the first n rows.
+// n is clamped to the row count; n <= 0 yields an empty table.
+func (t *Table) Head(n int) *Table {
+	n = clampRowCount(n, len(t.Rows))
+	return t.withRows(t.Rows[:n])
+}
+
+// Tail returns a new table containing the last n rows.
+// n is clamped to the row count; n <= 0 yields an empty table.
+func (t *Table) Tail(n int) *Table {
+	n = clampRowCount(n, len(t.Rows))
+	return t.withRows(t.Rows[len(t.Rows)-n:])
+}
+
+// Slice returns a new table containing rows in the range [start, end).
+// Bounds are clamped to the row count, and start and end are swapped if start > end.
+func (t *Table) Slice(start, end int) *Table {
+	if start > end {
+		start, end = end, start
+	}
+	start = clampRowCount(start, len(t.Rows))
+	end = clampRowCount(end, len(t.Rows))
+	return t.withRows(t.Rows[start:end])
+}
+
+// SortBy returns a new table with rows ordered by a column's values, compared
+// with Cell.Compare so numbers and dates sort by value. Empty cells sort
+// first when ascending. The sort is stable, so rows with equal values keep
+// their order and sorts can be chained from the least significant column.
+func (t *Table) SortBy(column string, ascending bool) *Table {
+	result := t.withRows(t.Rows)
+	sort.SliceStable(result.Rows, func(i, j int) bool {
+		a, b := result.Rows[i].Values[column], result.Rows[j].Values[column]
+		if ascending {
+			return a.Compare(b) < 0
+		}
+		return a.Compare(b) > 0
+	})
+	return result
+}
+
+// clampRowCount limits n to the range [0, total]
+func clampRowCount(n, total int) int {
+	if n < 0 {
+		return 0
+	}
+	if n > total {
+		return total
+	}
+	return n
+}
+
+// withRows returns a new table with the same metadata and a copy of the given rows
+func (t *Table) withRows(rows []Row) *Table {
+	result := &Table{
+		Name:        t.Name,
+		Headers:     t.Headers,
+		HeaderCells: t.HeaderCells,
+		Rows:        make([]Row, len(rows)),
+		StartRow:    t.StartRow,
+		EndRow:      t.EndRow,
+		StartCol:    t.StartCol,
+		EndCol:      t.EndCol,
+		HeaderRow:   t.HeaderRow,
+		Transposed:  t.Transposed,
+		Title:       t.Title,
+	}
+	copy(result.Rows, rows)
+	return result
+}
+
 // FindDuplicates returns rows that have duplicate values in the specified key column
 // The first occurrence is not included; only subsequent duplicates are returned
 func (t *Table) FindDuplicates(keyColumn string) []Row {
@@ -168,14 +459,17 @@ func (t *Table) Deduplicate(keyColumn string) *Table {
 	seen := make(map[string]bool)
 
 	deduped := &Table{
-		Name:      t.Name,
-		Headers:   t.Headers,
-		Rows:      make([]Row, 0),
-		StartRow:  t.StartRow,
-		EndRow:    t.EndRow,
-		StartCol:  t.StartCol,
-		EndCol:    t.EndCol,
-		HeaderRow: t.HeaderRow,
+		Name:        t.Name,
+		Headers:     t.Headers,
+		HeaderCells: t.HeaderCells,
+		Rows:        make([]Row, 0),
+		StartRow:    t.StartRow,
+		EndRow:      t.EndRow,
+		StartCol:    t.StartCol,
+		EndCol:      t.EndCol,
+		HeaderRow:   t.HeaderRow,
+		Transposed:  t.Transposed,
+		Title:       t.Title,
 	}
 
 	for _, row := range t.Rows {
@@ -191,22 +485,69 @@ func (t *Table) Deduplicate(keyColumn string) *Table {
 	return deduped
 }
 
+// DeduplicateBy returns a new table with duplicate rows removed based on a
+// composite key built from the given columns. Keeps the first occurrence of each key.
+// Only the keys are retained while scanning, so duplicate rows are never held in memory.
+func (t *Table) DeduplicateBy(columns ...string) *Table {
+	seen := make(map[string]struct{})
+
+	deduped := &Table{
+		Name:        t.Name,
+		Headers:     t.Headers,
+		HeaderCells: t.HeaderCells,
+		Rows:        make([]Row, 0),
+		StartRow:    t.StartRow,
+		EndRow:      t.EndRow,
+		StartCol:    t.StartCol,
+		EndCol:      t.EndCol,
+		HeaderRow:   t.HeaderRow,
+		Transposed:  t.Transposed,
+		Title:       t.Title,
+	}
+
+	for _, row := range t.Rows {
+		key := rowKey(row, columns)
+		if _, exists := seen[key]; !exists {
+			seen[key] = struct{}{}
+			deduped.Rows = append(deduped.Rows, row)
+		}
+	}
+
+	return deduped
+}
+
+// rowKey builds a composite key from the raw values of the given columns.
+// Missing columns contribute an empty value.
+func rowKey(row Row, columns []string) string {
+	parts := make([]string, len(columns))
+	for i, col := range columns {
+		if cell, ok := row.Get(col); ok {
+			parts[i] = cell.RawValue
+		}
+	}
+	return strings.Join(parts, "\x00") // Use null byte as separator
+}
+
 // DuplicateGroup represents a group of rows with the same key value
 type DuplicateGroup struct {
-	KeyValue string // The duplicate key value
-	Rows     []Row  // All rows with this key value
-	Count    int    // Number of occurrences
+	KeyValue   string   // The duplicate key value (composite keys are joined with ", ")
+	KeyValues  []string // The individual key values, one per key column
+	Rows       []Row    // All rows with this key value
+	RowIndices []int    // Positions of the rows within Table.Rows (0-based)
+	Count      int      // Number of occurrences
 }
 
 // FindDuplicateGroups returns groups of rows that share the same key value
 // Only includes groups with more than one row (actual duplicates)
 func (t *Table) FindDuplicateGroups(keyColumn string) []DuplicateGroup {
 	groups := make(map[string][]Row)
+	indices := make(map[string][]int)
 
-	for _, row := range t.Rows {
+	for i, row := range t.Rows {
 		if cell, ok := row.Get(keyColumn); ok {
 			key := cell.RawValue
 			groups[key] = append(groups[key], row)
+			indices[key] = append(indices[key], i)
 		}
 	}
 
@@ -215,9 +556,11 @@ func (t *Table) FindDuplicateGroups(keyColumn string) []DuplicateGroup {
 	for key, rows := range groups {
 		if len(rows) > 1 {
 			result = append(result, DuplicateGroup{
-				KeyValue: key,
-				Rows:     rows,
-				Count:    len(rows),
+				KeyValue:   key,
+				KeyValues:  []string{key},
+				Rows:       rows,
+				RowIndices: indices[key],
+				Count:      len(rows),
 			})
 		}
 	}
@@ -225,17 +568,59 @@ func (t *Table) FindDuplicateGroups(keyColumn string) []DuplicateGroup {
 	return result
 }
 
+// FindDuplicateGroupsBy returns groups of rows that share the same values across
+// all the given key columns. Groups are ordered by the first occurrence of their key,
+// and only groups with more than one row are included.
+func (t *Table) FindDuplicateGroupsBy(columns ...string) []DuplicateGroup {
+	groups := make(map[string]*DuplicateGroup)
+	order := make([]string, 0)
+
+	for i, row := range t.Rows {
+		key := rowKey(row, columns)
+		group, exists := groups[key]
+		if !exists {
+			keyValues := make([]string, len(columns))
+			for j, col := range columns {
+				if cell, ok := row.Get(col); ok {
+					keyValues[j] = cell.RawValue
+				}
+			}
+			group = &DuplicateGroup{
+				KeyValue:  strings.Join(keyValues, ", "),
+				KeyValues: keyValues,
+			}
+			groups[key] = group
+			order = append(order, key)
+		}
+		group.Rows = append(group.Rows, row)
+		group.RowIndices = append(group.RowIndices, i)
+		group.Count++
+	}
+
+	// Filter to only groups with duplicates
+	result := make([]DuplicateGroup, 0)
+	for _, key := range order {
+		if group := groups[key]; group.Count > 1 {
+			result = append(result, *group)
+		}
+	}
+
+	return result
+}
+
 // Select returns a new table with only the specified columns
 func (t *Table) Select(columns ...string) *Table {
 	selected := &Table{
-		Name:      t.Name,
-		Headers:   make([]string, 0, len(columns)),
-		Rows:      make([]Row, 0, len(t.Rows)),
-		StartRow:  t.StartRow,
-		EndRow:    t.EndRow,
-		StartCol:  t.StartCol,
-		EndCol:    t.EndCol,
-		HeaderRow: t.HeaderRow,
+		Name:       t.Name,
+		Headers:    make([]string, 0, len(columns)),
+		Rows:       make([]Row, 0, len(t.Rows)),
+		StartRow:   t.StartRow,
+		EndRow:     t.EndRow,
+		StartCol:   t.StartCol,
+		EndCol:     t.EndCol,
+		HeaderRow:  t.HeaderRow,
+		Transposed: t.Transposed,
+		Title:      t.Title,
 	}
 
 	// Build set of valid columns for quick lookup
@@ -250,6 +635,7 @@ func (t *Table) Select(columns ...string) *Table {
 			selected.Headers = append(selected.Headers, col)
 		}
 	}
+	selected.HeaderCells = t.headerCellsFor(selected.Headers)
 
 	// Copy rows with only selected columns
 	for _, row := range t.Rows {
@@ -272,18 +658,270 @@ func (t *Table) Select(columns ...string) *Table {
 	return selected
 }
 
+// DropColumns returns a new table without the specified columns
+// Names that are not headers are ignored
+func (t *Table) DropColumns(columns ...string) *Table {
+	drop := make(map[string]bool, len(columns))
+	for _, col := range columns {
+		drop[col] = true
+	}
+
+	keep := make([]string, 0, len(t.Headers))
+	for _, h := range t.Headers {
+		if !drop[h] {
+			keep = append(keep, h)
+		}
+	}
+	return t.Select(keep...)
+}
+
+// Enrich returns a new table with columns copied from the lookup row whose on
+// value matches each row's, like a left join that keeps the table's row count.
+// With no columns named, every lookup column except on is brought. Rows without
+// a match get empty cells, the first of several matching lookup rows is used,
+// and a brought column the table already has is overwritten.
+func (t *Table) Enrich(lookup *Table, on string, bring ...string) *Table {
+	index := make(map[string]Row)
+	var lookupHeaders []string
+	if lookup != nil {
+		lookupHeaders = lookup.Headers
+		for _, row := range lookup.Rows {
+			key := row.Values[on].RawValue
+			if _, seen := index[key]; key != "" && !seen {
+				index[key] = row
+			}
+		}
+	}
+
+	if len(bring) == 0 {
+		for _, h := range lookupHeaders {
+			if h != on {
+				bring = append(bring, h)
+			}
+		}
+	}
+	available := make(map[string]bool, len(lookupHeaders))
+	for _, h := range lookupHeaders {
+		available[h] = true
+	}
+	existing := make(map[string]bool, len(t.Headers))
+	for _, h := range t.Headers {
+		existing[h] = true
+	}
+
+	result := t.withRows(t.copyRows())
+	result.Headers = append([]string(nil), t.Headers...)
+	result.HeaderCells = append([]Cell(nil), t.HeaderCells...)
+	var columns []string
+	for _, col := range bring {
+		if !available[col] {
+			continue
+		}
+		columns = append(columns, col)
+		if !existing[col] {
+			existing[col] = true
+			result.Headers = append(result.Headers, col)
+			if len(t.HeaderCells) > 0 {
+				cell, _ := lookup.HeaderCell(col)
+				result.HeaderCells = append(result.HeaderCells, cell)
+			}
+		}
+	}
+
+	for i := range result.Rows {
+		row := &result.Rows[i]
+		if len(row.Cells) == len(t.Headers) {
+			row.Cells = append(row.Cells, make([]Cell, len(result.Headers)-len(t.Headers))...)
+		}
+		match, matched := index[row.Values[on].RawValue]
+		for _, col := range columns {
+			cell := Cell{Type: CellTypeEmpty}
+			if matched {
+				cell = match.Values[col]
+			}
+			result.setCell(row, col, cell)
+		}
+	}
+	return result
+}
+
+// Coalesce returns a new table with a column holding, for each row, the first
+// non-empty cell among the source columns, e.g. one Phone column from Phone1,
+// Phone2 and Phone3. The sources are kept; a newCol the table already has is
+// overwritten. Rows with every source empty get an empty cell.
+func (t *Table) Coalesce(newCol string, sources ...string) *Table {
+	result := t.withRows(t.copyRows())
+	if !t.hasHeader(newCol) {
+		result.Headers = append(append([]string(nil), t.Headers...), newCol)
+		if len(t.HeaderCells) > 0 {
+			header := Cell{Value: newCol, Type: CellTypeString, RawValue: newCol, Row: t.HeaderRow}
+			result.HeaderCells = append(append([]Cell(nil), t.HeaderCells...), header)
+		}
+	}
+
+	for i := range result.Rows {
+		row := &result.Rows[i]
+		if len(row.Cells) == len(t.Headers) && len(result.Headers) > len(t.Headers) {
+			row.Cells = append(row.Cells, Cell{})
+		}
+		cell := Cell{Type: CellTypeEmpty}
+		for _, src := range sources {
+			if c, ok := row.Values[src]; ok && !c.IsEmpty() {
+				cell = c
+				break
+			}
+		}
+		result.setCell(row, newCol, cell)
+	}
+	return result
+}
+
+// FillDown returns a new table in which empty cells in the given columns take
+// the last non-empty value above them, like pandas ffill. With no columns,
+// every column is filled. Filled cells keep their own Row and Col.
+func (t *Table) FillDown(columns ...string) *Table {
+	if len(columns) == 0 {
+		columns = t.Headers
+	}
+	rows := t.copyRows()
+	for _, col := range columns {
+		var last *Cell
+		for i := range rows {
+			cell, ok := rows[i].Values[col]
+			if ok && !cell.IsEmpty() {
+				c := cell
+				last = &c
+				continue
+			}
+			if last == nil {
+				continue
+			}
+			cell.Value = last.Value
+			cell.Type = last.Type
+			cell.RawValue = last.RawValue
+			cell.NumberFormat = last.NumberFormat
+			t.setCell(&rows[i], col, cell)
+		}
+	}
+
+	return t.withRows(rows)
+}
+
+// ReplaceValues returns a new table in which cells of the column whose RawValue
+// is a key of mapping are rewritten to the mapped value and their type inferred
+// again, e.g. "N/A" to "" for an empty cell or "Y" to "true" for a bool
+func (t *Table) ReplaceValues(column string, mapping map[string]string) *Table {
+	return t.replaceValues([]string{column}, mapping)
+}
+
+// ReplaceAllValues is like ReplaceValues but applies the mapping to every column
+func (t *Table) ReplaceAllValues(mapping map[string]string) *Table {
+	return t.replaceValues(t.Headers, mapping)
+}
+
+// replaceValues applies a value mapping to the given columns
+func (t *Table) replaceValues(columns []string, mapping map[string]string) *Table {
+	rows := t.copyRows()
+	for i := range rows {
+		for _, col := range columns {
+			cell, ok := rows[i].Values[col]
+			if !ok {
+				continue
+			}
+			replacement, ok := mapping[cell.RawValue]
+			if !ok {
+				continue
+			}
+			cell.RawValue = replacement
+			cell.Value, cell.Type = InferValue(replacement)
+			cell.NumberFormat = ""
+			t.setCell(&rows[i], col, cell)
+		}
+	}
+	return t.withRows(rows)
+}
+
+// copyRows returns a deep copy of the rows' Values maps and Cells slices
+func (t *Table) copyRows() []Row {
+	rows := make([]Row, len(t.Rows))
+	for i, row := range t.Rows {
+		rows[i] = row.Clone()
+	}
+	return rows
+}
+
+// setCell stores a cell in a row's Values and, when Cells follows the header
+// order, in Cells as well
+func (t *Table) setCell(row *Row, header string, cell Cell) {
+	row.Values[header] = cell
+	if len(row.Cells) != len(t.Headers) {
+		return
+	}
+	for i, h := range t.Headers {
+		if h == header {
+			row.Cells[i] = cell
+			return
+		}
+	}
+}
+
+// dateLayouts are the date layouts recognised when inferring a value's type
+var dateLayouts = []string{
+	"2006-01-02",
+	"01/02/2006",
+	"02/01/2006",
+	"2006/01/02",
+	"Jan 2, 2006",
+	"January 2, 2006",
+	"02-Jan-2006",
+	"2006-01-02 15:04:05",
+	"01/02/2006 15:04:05",
+}
+
+// InferValue parses a raw value the way the reader does: empty, bool, number,
+// date, or string
+func InferValue(raw string) (interface{}, CellType) {
+	if raw == "" {
+		return nil, CellTypeEmpty
+	}
+	if lower := strings.ToLower(raw); lower == "true" || lower == "false" {
+		return lower == "true", CellTypeBool
+	}
+	if f, err := strconv.ParseFloat(raw, 64); err == nil {
+		return f, CellTypeNumber
+	}
+	if tm, ok := ParseDate(raw); ok {
+		return tm, CellTypeDate
+	}
+	return raw, CellTypeString
+}
+
+// ParseDate parses text written exactly in one of the date layouts recognised
+// when inferring types, such as "2006-01-02" or "Jan 2, 2006"
+func ParseDate(value string) (time.Time, bool) {
+	for _, layout := range dateLayouts {
+		if tm, err := time.Parse(layout, value); err == nil {
+			return tm, true
+		}
+	}
+	return time.Time{}, false
+}
+
 // Rename returns a new table with columns renamed according to the mapping
 // The map keys are old column names, values are new column names
 func (t *Table) Rename(mapping map[string]string) *Table {
 	renamed := &Table{
-		Name:      t.Name,
-		Headers:   make([]string, len(t.Headers)),
-		Rows:      make([]Row, 0, len(t.Rows)),
-		StartRow:  t.StartRow,
-		EndRow:    t.EndRow,
-		StartCol:  t.StartCol,
-		EndCol:    t.EndCol,
-		HeaderRow: t.HeaderRow,
+		Name:        t.Name,
+		Headers:     make([]string, len(t.Headers)),
+		HeaderCells: t.HeaderCells,
+		Rows:        make([]Row, 0, len(t.Rows)),
+		StartRow:    t.StartRow,
+		EndRow:      t.EndRow,
+		StartCol:    t.StartCol,
+		EndCol:      t.EndCol,
+		HeaderRow:   t.HeaderRow,
+		Transposed:  t.Transposed,
+		Title:       t.Title,
 	}
 
 	// Rename headers
@@ -318,18 +956,65 @@ func (t *Table) Rename(mapping map[string]string) *Table {
 	return renamed
 }
 
+// RenameFunc returns a new table with every column renamed by fn.
+// Names that collide after renaming get numeric suffixes (_2, _3, ...).
+func (t *Table) RenameFunc(fn func(string) string) *Table {
+	mapping := make(map[string]string, len(t.Headers))
+	used := make(map[string]bool, len(t.Headers))
+
+	for _, h := range t.Headers {
+		name := fn(h)
+		if used[name] {
+			for i := 2; ; i++ {
+				candidate := fmt.Sprintf("%s_%d", name, i)
+				if !used[candidate] {
+					name = candidate
+					break
+				}
+			}
+		}
+		used[name] = true
+		mapping[h] = name
+	}
+
+	return t.Rename(mapping)
+}
+
+// WithHeaders returns a new table with headers replaced positionally, for when
+// detected headers (such as generated Column_N names) are wrong. The number of
+// headers must match ColCount and names must be unique.
+func (t *Table) WithHeaders(headers []string) (*Table, error) {
+	if len(headers) != t.ColCount() {
+		return nil, fmt.Errorf("got %d headers, table has %d columns", len(headers), t.ColCount())
+	}
+
+	mapping := make(map[string]string, len(headers))
+	used := make(map[string]bool, len(headers))
+	for i, h := range headers {
+		if used[h] {
+			return nil, fmt.Errorf("duplicate header: %s", h)
+		}
+		used[h] = true
+		mapping[t.Headers[i]] = h
+	}
+
+	return t.Rename(mapping), nil
+}
+
 // Reorder returns a new table with columns in the specified order
 // Columns not in the list are excluded from the result
 func (t *Table) Reorder(columns ...string) *Table {
 	reordered := &Table{
-		Name:      t.Name,
-		Headers:   make([]string, 0, len(columns)),
-		Rows:      make([]Row, 0, len(t.Rows)),
-		StartRow:  t.StartRow,
-		EndRow:    t.EndRow,
-		StartCol:  t.StartCol,
-		EndCol:    t.EndCol,
-		HeaderRow: t.HeaderRow,
+		Name:       t.Name,
+		Headers:    make([]string, 0, len(columns)),
+		Rows:       make([]Row, 0, len(t.Rows)),
+		StartRow:   t.StartRow,
+		EndRow:     t.EndRow,
+		StartCol:   t.StartCol,
+		EndCol:     t.EndCol,
+		HeaderRow:  t.HeaderRow,
+		Transposed: t.Transposed,
+		Title:      t.Title,
 	}
 
 	// Build set of valid columns
@@ -344,6 +1029,7 @@ func (t *Table) Reorder(columns ...string) *Table {
 			reordered.Headers = append(reordered.Headers, col)
 		}
 	}
+	reordered.HeaderCells = t.headerCellsFor(reordered.Headers)
 
 	// Copy rows with reordered columns
 	for _, row := range t.Rows {
@@ -366,29 +1052,200 @@ func (t *Table) Reorder(columns ...string) *Table {
 	return reordered
 }
 
+// Unpivot returns a new table in long format, like pandas melt: each row
+// becomes one row per value column, holding the idVars columns, the value
+// column's name under varName and its cell under valueName. Rows keep the
+// source order, with the value columns in the order given. With no valueVars
+// every column not in idVars is unpivoted; unknown columns are ignored and
+// empty names default to "variable" and "value".
+func (t *Table) Unpivot(idVars []string, valueVars []string, varName, valueName string) *Table {
+	if varName == "" {
+		varName = "variable"
+	}
+	if valueName == "" {
+		valueName = "value"
+	}
+
+	isID := make(map[string]bool, len(idVars))
+	var ids []string
+	for _, col := range idVars {
+		if t.hasHeader(col) && !isID[col] {
+			isID[col] = true
+			ids = append(ids, col)
+		}
+	}
+	var values []string
+	if len(valueVars) == 0 {
+		for _, h := range t.Headers {
+			if !isID[h] {
+				values = append(values, h)
+			}
+		}
+	}
+	for _, col := range valueVars {
+		if t.hasHeader(col) {
+			values = append(values, col)
+		}
+	}
+
+	result := t.withRows(nil)
+	result.Headers = append(append([]string(nil), ids...), varName, valueName)
+	result.HeaderCells = nil
+	if len(t.HeaderCells) > 0 {
+		result.HeaderCells = t.headerCellsFor(ids)
+		for _, name := range []string{varName, valueName} {
+			result.HeaderCells = append(result.HeaderCells, Cell{Value: name, Type: CellTypeString, RawValue: name, Row: t.HeaderRow})
+		}
+	}
+
+	result.Rows = make([]Row, 0, len(t.Rows)*len(values))
+	for _, row := range t.Rows {
+		for _, col := range values {
+			newRow := Row{
+				Index:  row.Index,
+				Values: make(map[string]Cell, len(result.Headers)),
+				Cells:  make([]Cell, 0, len(result.Headers)),
+			}
+			cells := make([]Cell, 0, len(result.Headers))
+			for _, id := range ids {
+				cells = append(cells, row.Values[id])
+			}
+			value, ok := row.Values[col]
+			if !ok {
+				value = Cell{Type: CellTypeEmpty}
+			}
+			cells = append(cells, Cell{Value: col, Type: CellTypeString, RawValue: col}, value)
+			for i, cell := range cells {
+				newRow.Values[result.Headers[i]] = cell
+				newRow.Cells = append(newRow.Cells, cell)
+			}
+			result.Rows = append(result.Rows, newRow)
+		}
+	}
+	return result
+}
+
+// Transpose returns a new table with rows and columns swapped: each original
+// column becomes a row and each original row becomes a column, so a 2x3 table
+// gives a 3x2 one. New headers are taken from the first column's values; empty
+// values become Column_N and duplicates get numeric suffixes. Cells keep their
+// types and coordinates, and the bounds are swapped to match.
+func (t *Table) Transpose() *Table {
+	transposed := &Table{
+		Name:       t.Name,
+		Headers:    make([]string, len(t.Rows)),
+		Rows:       make([]Row, 0, len(t.Headers)),
+		StartRow:   t.StartCol,
+		EndRow:     t.EndCol,
+		StartCol:   t.StartRow,
+		EndCol:     t.EndRow,
+		HeaderRow:  t.HeaderRow,
+		Transposed: !t.Transposed,
+		Title:      t.Title,
+	}
+
+	// Derive headers from the first column
+	used := make(map[string]bool, len(t.Rows))
+	for i, row := range t.Rows {
+		name := ""
+		if len(t.Headers) > 0 {
+			if cell, ok := row.Values[t.Headers[0]]; ok {
+				name = strings.TrimSpace(cell.RawValue)
+			}
+		}
+		if name == "" {
+			name = fmt.Sprintf("Column_%d", i+1)
+		}
+		if used[name] {
+			for n := 2; ; n++ {
+				candidate := fmt.Sprintf("%s_%d", name, n)
+				if !used[candidate] {
+					name = candidate
+					break
+				}
+			}
+		}
+		used[name] = true
+		transposed.Headers[i] = name
+	}
+
+	// Each original column becomes a row
+	for colIdx, header := range t.Headers {
+		newRow := Row{
+			Index:  colIdx,
+			Values: make(map[string]Cell, len(t.Rows)),
+			Cells:  make([]Cell, 0, len(t.Rows)),
+		}
+		for i, row := range t.Rows {
+			cell, ok := row.Values[header]
+			if !ok {
+				cell = Cell{Type: CellTypeEmpty}
+			}
+			newRow.Values[transposed.Headers[i]] = cell
+			newRow.Cells = append(newRow.Cells, cell)
+		}
+		transposed.Rows = append(transposed.Rows, newRow)
+	}
+
+	return transposed
+}
+
 // ColumnStats holds statistical information about a column
 type ColumnStats struct {
-	Name          string   // Column header name
-	Index         int      // Column index (0-based)
-	InferredType  CellType // Most common non-empty cell type
-	TotalCount    int      // Total number of cells
-	EmptyCount    int      // Number of empty cells
-	NullCount     int      // Number of null/empty values
-	StringCount   int      // Number of string values
-	NumberCount   int      // Number of numeric values
-	DateCount     int      // Number of date values
-	BoolCount     int      // Number of boolean values
-	UniqueCount   int      // Number of unique values
-	SampleValues  []string // Sample of values (up to 5)
-	Min           float64  // Minimum numeric value (only valid if HasNumericStats is true)
-	Max           float64  // Maximum numeric value (only valid if HasNumericStats is true)
-	Sum           float64  // Sum of numeric values (only valid if HasNumericStats is true)
-	Avg           float64  // Average of numeric values (only valid if HasNumericStats is true)
-	HasNumericStats bool   // True if Min/Max/Sum/Avg are valid (column has numeric values)
+	Name            string       // Column header name
+	Index           int          // Column index (0-based)
+	InferredType    CellType     // Most common non-empty cell type
+	TotalCount      int          // Total number of cells
+	EmptyCount      int          // Number of empty cells
+	NullCount       int          // Number of null/empty values
+	StringCount     int          // Number of string values
+	NumberCount     int          // Number of numeric values
+	DateCount       int          // Number of date values
+	BoolCount       int          // Number of boolean values
+	UniqueCount     int          // Number of unique values
+	IsUnique        bool         // True if every non-empty value is distinct
+	IsConstant      bool         // True if all non-empty values are the same
+	SampleValues    []string     // Sample of values (up to 5)
+	Min             float64      // Minimum numeric value (only valid if HasNumericStats is true)
+	Max             float64      // Maximum numeric value (only valid if HasNumericStats is true)
+	Sum             float64      // Sum of numeric values (only valid if HasNumericStats is true)
+	Avg             float64      // Average of numeric values (only valid if HasNumericStats is true)
+	HasNumericStats bool         // True if Min/Max/Sum/Avg are valid (column has numeric values)
+	DetectedFormat  string       // Likely semantic format (e.g. "email", "url"), empty if none
+	TopValues       []ValueCount // Most frequent values, sorted by count descending
+}
+
+// ValueCount pairs a raw value with its number of occurrences
+type ValueCount struct {
+	Value string
+	Count int
+}
+
+// AnalyzeOptions configures column analysis
+type AnalyzeOptions struct {
+	// FormatThreshold is the minimum fraction of non-empty values that must match
+	// a known format for it to be reported in DetectedFormat (0 disables detection)
+	FormatThreshold float64
+
+	// TopN limits the number of entries in TopValues (0 disables frequency tracking)
+	TopN int
+}
+
+// DefaultAnalyzeOptions returns the default column analysis options
+func DefaultAnalyzeOptions() AnalyzeOptions {
+	return AnalyzeOptions{
+		FormatThreshold: 0.9,
+		TopN:            5,
+	}
 }
 
 // AnalyzeColumns returns statistical analysis for each column in the table
 func (t *Table) AnalyzeColumns() []ColumnStats {
+	return t.AnalyzeColumnsWithOptions(DefaultAnalyzeOptions())
+}
+
+// AnalyzeColumnsWithOptions returns statistical analysis for each column using custom options
+func (t *Table) AnalyzeColumnsWithOptions(opts AnalyzeOptions) []ColumnStats {
 	if len(t.Headers) == 0 {
 		return nil
 	}
@@ -407,10 +1264,16 @@ func (t *Table) AnalyzeColumns() []ColumnStats {
 		}
 	}
 
-	// Track unique values per column
-	uniqueValues := make([]map[string]struct{}, len(t.Headers))
+	// Track unique values and their frequencies per column
+	uniqueValues := make([]map[string]int, len(t.Headers))
 	for i := range uniqueValues {
-		uniqueValues[i] = make(map[string]struct{})
+		uniqueValues[i] = make(map[string]int)
+	}
+
+	// Track format matches per column
+	formatCounts := make([][]int, len(t.Headers))
+	for i := range formatCounts {
+		formatCounts[i] = make([]int, len(knownFormats))
 	}
 
 	// Analyze each row
@@ -450,21 +1313,29 @@ func (t *Table) AnalyzeColumns() []ColumnStats {
 
 			// Track unique values
 			rawVal := cell.RawValue
+			if opts.FormatThreshold > 0 {
+				matchFormats(rawVal, formatCounts[i])
+			}
 			if _, seen := uniqueValues[i][rawVal]; !seen {
-				uniqueValues[i][rawVal] = struct{}{}
-
 				// Collect sample values (up to 5)
 				if len(stats[i].SampleValues) < 5 {
 					stats[i].SampleValues = append(stats[i].SampleValues, rawVal)
 				}
 			}
+			uniqueValues[i][rawVal]++
 		}
 	}
 
 	// Finalize stats
 	for i := range stats {
 		stats[i].UniqueCount = len(uniqueValues[i])
+		stats[i].IsUnique = stats[i].UniqueCount > 0 && stats[i].UniqueCount == stats[i].TotalCount-stats[i].EmptyCount
+		stats[i].IsConstant = stats[i].UniqueCount == 1
 		stats[i].InferredType = inferColumnType(stats[i])
+		stats[i].DetectedFormat = detectFormat(formatCounts[i], stats[i].TotalCount-stats[i].EmptyCount, opts.FormatThreshold)
+		if opts.TopN > 0 {
+			stats[i].TopValues = topValues(uniqueValues[i], opts.TopN)
+		}
 
 		// Compute average and set HasNumericStats flag
 		if stats[i].NumberCount > 0 {
@@ -480,6 +1351,26 @@ func (t *Table) AnalyzeColumns() []ColumnStats {
 	return stats
 }
 
+// topValues returns the n most frequent values, ties broken alphabetically
+func topValues(counts map[string]int, n int) []ValueCount {
+	result := make([]ValueCount, 0, len(counts))
+	for value, count := range counts {
+		result = append(result, ValueCount{Value: value, Count: count})
+	}
+
+	sort.Slice(result, func(a, b int) bool {
+		if result[a].Count != result[b].Count {
+			return result[a].Count > result[b].Count
+		}
+		return result[a].Value < result[b].Value
+	})
+
+	if len(result) > n {
+		result = result[:n]
+	}
+	return result
+}
+
 // inferColumnType determines the dominant type for a column
 func inferColumnType(s ColumnStats) CellType {
 	nonEmpty := s.TotalCount - s.EmptyCount
@@ -508,15 +1399,144 @@ func inferColumnType(s ColumnStats) CellType {
 
 // Sheet represents an Excel sheet containing one or more tables
 type Sheet struct {
-	Name   string
-	Index  int
-	Tables []Table
+	Name       string
+	Index      int
+	Visibility SheetVisibility
+	SourceFile string // Path of the file the sheet was read from
+	Tables     []Table
+
+	// ExcelTables lists the ranges defined in the file: Excel Tables (ListObjects) and the AutoFilter
+	ExcelTables []ExcelTableRef
+
+	FrozenRows int    // Number of rows frozen at the top of the sheet
+	FrozenCols int    // Number of columns frozen at the left of the sheet
+	PrintArea  string // Print area range such as "A1:D20"; empty if none is set
+}
+
+// ExcelTableRef is a range the workbook itself defines as tabular data
+type ExcelTableRef struct {
+	Name       string // ListObject name; empty for an AutoFilter
+	Range      string // Cell range such as "A1:D20"
+	AutoFilter bool   // true if the range is the sheet's AutoFilter rather than a ListObject
+}
+
+// SheetVisibility represents whether a sheet is shown in Excel
+type SheetVisibility int
+
+const (
+	VisibilityVisible    SheetVisibility = iota
+	VisibilityHidden                     // Hidden, can be unhidden from the Excel UI
+	VisibilityVeryHidden                 // Hidden, can only be unhidden programmatically
+)
+
+// String returns the string representation of the visibility
+func (v SheetVisibility) String() string {
+	switch v {
+	case VisibilityHidden:
+		return "hidden"
+	case VisibilityVeryHidden:
+		return "veryHidden"
+	default:
+		return "visible"
+	}
 }
 
 // Workbook represents an Excel file with multiple sheets
 type Workbook struct {
-	FilePath string
-	Sheets   []Sheet
+	FilePath   string
+	Sheets     []Sheet
+	Properties DocumentProperties // Document properties recorded in the file
+}
+
+// DocumentProperties holds a workbook's author, date and calculation metadata.
+// Fields the file does not record are left empty.
+type DocumentProperties struct {
+	Creator        string
+	LastModifiedBy string
+	Created        time.Time
+	Modified       time.Time
+	Title          string
+	Company        string
+	CalcMode       string // Calculation mode: "auto", "autoNoTable" or "manual"
+}
+
+// Merge returns a new workbook holding the sheets of w followed by those of others.
+// Sheets without a SourceFile are tagged with the FilePath of their workbook.
+func (w *Workbook) Merge(others ...*Workbook) *Workbook {
+	result := &Workbook{}
+	for _, wb := range append([]*Workbook{w}, others...) {
+		if wb == nil {
+			continue
+		}
+		for _, sheet := range wb.Sheets {
+			if sheet.SourceFile == "" {
+				sheet.SourceFile = wb.FilePath
+			}
+			result.Sheets = append(result.Sheets, sheet)
+		}
+	}
+	return result
+}
+
+// ConcatTables returns a table holding the rows of every table with the given name,
+// in sheet order. The metadata of the first match is kept; nil if none match.
+func (w *Workbook) ConcatTables(name string) *Table {
+	var first *Table
+	var rows []Row
+	for i := range w.Sheets {
+		for j := range w.Sheets[i].Tables {
+			table := &w.Sheets[i].Tables[j]
+			if table.Name != name {
+				continue
+			}
+			if first == nil {
+				first = table
+			}
+			rows = append(rows, table.Rows...)
+		}
+	}
+	if first == nil {
+		return nil
+	}
+	return first.withRows(rows)
+}
+
+// Location identifies a row within a workbook
+type Location struct {
+	Sheet string // Sheet name
+	Table string // Table name
+	Row   int    // Position of the row within Table.Rows (0-based)
+}
+
+// FindCrossSheetDuplicates returns, for each value of column that appears on
+// more than one sheet, every location holding it. Tables without the column
+// and empty values are skipped. Locations are in sheet, table and row order.
+func (w *Workbook) FindCrossSheetDuplicates(column string) map[string][]Location {
+	locations := make(map[string][]Location)
+	sheets := make(map[string]map[int]bool)
+	for si, sheet := range w.Sheets {
+		for _, table := range sheet.Tables {
+			for i, row := range table.Rows {
+				cell, ok := row.Get(column)
+				if !ok || cell.IsEmpty() {
+					continue
+				}
+				key := cell.RawValue
+				locations[key] = append(locations[key], Location{Sheet: sheet.Name, Table: table.Name, Row: i})
+				if sheets[key] == nil {
+					sheets[key] = make(map[int]bool)
+				}
+				sheets[key][si] = true
+			}
+		}
+	}
+
+	for key := range locations {
+		if len(sheets[key]) < 2 {
+			delete(locations, key)
+		}
+	}
+	return locations
 }
 
 // TableBoundary represents the detected boundaries of a table
@@ -539,12 +1559,85 @@ type DetectionConfig struct {
 	MinColumns         int     // Minimum columns to consider as a table
 	MinRows            int     // Minimum rows to consider as a table
 	MaxEmptyRows       int     // Max consecutive empty rows before table ends
+	TableSeparatorRows int     // Blank rows that separate two tables on a sheet (0 = use MaxEmptyRows)
+	DetectTransposed   bool    // When true, read tables with headers in the first column as transposed
 	HeaderDensity      float64 // Minimum density of non-empty cells for header
 	ColumnConsistency  float64 // Minimum consistency of column data types
-	ExpandMergedCells  bool    // When true, copy merged cell value to all cells in range
+	ExpandMergedCells  bool    // When true, copy merged cell values as selected by MergeFill
 	TrackMergeMetadata bool    // When true, populate IsMerged and MergeRange fields
+	SkipHidden         bool    // When true, omit hidden rows and columns from the grid
+	SkipHiddenSheets   bool    // When true, omit hidden and very hidden sheets from the workbook
+
+	FlattenHierarchicalHeaders bool   // When true, combine multi-row headers into single column names
+	HeaderSeparator            string // Separator between header levels when flattening (default " > ")
+	MergeContinuationRows      bool   // When true, append rows holding only wrapped text to the row above
+	TrustExcelTypes            bool   // When true, take cell types from the file and infer only for untyped cells
+	CoalesceHeaderWords        bool   // When true, join header words stacked over two unmerged rows with a space
+	PreferNativeTables         bool   // When true, use Excel Table and AutoFilter ranges in place of detected tables they overlap
+	StreamingSheetRead         bool   // When true, build the grid while iterating rows instead of loading them all first
+
+	NumberLocale    NumberLocale // Grouping and decimal separators used to parse numbers stored as text
+	BoolTrueValues  []string     // Extra strings read as true, e.g. "Yes" (case-insensitive)
+	BoolFalseValues []string     // Extra strings read as false, e.g. "No" (case-insensitive)
+	MergeFill       MergeFill    // Which cells of a merged range receive its value (default FillAll)
+
+	// TableNamer names each detected table from its sheet, 1-based position on
+	// the sheet and headers. Nil or an empty result uses "<sheet>_Table<index>".
+	TableNamer func(sheet string, index int, headers []string) string
+
+	CaptureTitles bool // When true, store a lone text line just above a table's header as Table.Title
+
+	MaxScanColumns int // Columns read from each row; values further right are ignored (0 = no limit)
+
+	KeepTrailingEmptyColumns bool // When true, widen tables to every column with data in any row, naming unlabeled ones Column_N
+
+	// DuplicateHeaderResolver renames a header already used earlier in the row,
+	// given the name and its 0-based sheet column. Nil or an empty result
+	// appends a count instead, e.g. "Name_2".
+	DuplicateHeaderResolver func(name string, colIndex int) string
+
+	RemoveRepeatedHeaders bool // When true, drop data rows identical to the header row, as repeated on each page of printed reports
+
+	// ForceStringColumns names columns, matched against the detected headers,
+	// whose cells are always strings holding the text read from the file, so
+	// IDs and zip codes such as "01234" are not turned into numbers.
+	// ForceStringColumnIndexes selects columns by 0-based sheet column instead.
+	ForceStringColumns       []string
+	ForceStringColumnIndexes []int
+
+	// StripTextMarker reads cells whose style has Excel's quotePrefix text
+	// marker, set for values typed with a leading apostrophe such as '007, as
+	// strings, dropping an apostrophe that leaked into the text. Other cells
+	// starting with an apostrophe, such as '90s, are left as they are.
+	StripTextMarker bool
+
+	// IgnoreTablesWithoutHeaders drops detected tables none of whose headers
+	// contain one of these keywords (case-insensitive), such as metadata
+	// blocks beside the real data. Blank keywords are ignored. AcceptTable,
+	// when set, also has to return true for a table to be kept.
+	IgnoreTablesWithoutHeaders []string
+	AcceptTable                func(table *Table) bool
 }
 
+// MergeFill selects which cells of a merged range hold the merged value
+type MergeFill int
+
+const (
+	FillAll        MergeFill = iota // Every cell in the range holds the origin's value
+	FillOriginOnly                  // Only the top-left cell holds the value; the rest are empty
+	FillNone                        // Cells keep the values read from the file
+)
+
+// NumberLocale selects the separators used in numbers written as text
+type NumberLocale int
+
+const (
+	LocaleNone  NumberLocale = iota // Only plain numbers such as 1234.56 are parsed
+	LocaleUS                        // 1,234.56
+	LocaleEU                        // 1.234,56
+	LocaleSpace                     // 1 234,56
+)
+
 // DefaultConfig returns the default detection configuration
 func DefaultConfig() DetectionConfig {
 	return DetectionConfig{
@@ -555,9 +1648,14 @@ func DefaultConfig() DetectionConfig {
 		ColumnConsistency:  0.7,
 		ExpandMergedCells:  true,
 		TrackMergeMetadata: true,
+		HeaderSeparator:    DefaultHeaderSeparator,
+		StripTextMarker:    true,
 	}
 }
 
+// DefaultHeaderSeparator joins header levels when flattening hierarchical headers
+const DefaultHeaderSeparator = " > "
+
 // CellDiff represents a change in a single cell
 type CellDiff struct {
 	Column   string // Column name where the change occurred
@@ -662,21 +1760,18 @@ func compareRows(oldRow, newRow Row, headers []string, keyColumn string) []CellD
 		oldCell, oldExists := oldRow.Get(header)
 		newCell, newExists := newRow.Get(header)
 
-		oldValue := ""
-		newValue := ""
-
-		if oldExists {
-			oldValue = oldCell.RawValue
+		if !oldExists {
+			oldCell = Cell{Type: CellTypeEmpty}
 		}
-		if newExists {
-			newValue = newCell.RawValue
+		if !newExists {
+			newCell = Cell{Type: CellTypeEmpty}
 		}
 
-		if oldValue != newValue {
+		if !oldCell.Equal(newCell) {
 			changes = append(changes, CellDiff{
 				Column:   header,
-				OldValue: oldValue,
-				NewValue: newValue,
+				OldValue: oldCell.RawValue,
+				NewValue: newCell.RawValue,
 			})
 		}
 	}
@@ -1,6 +1,8 @@
 package models
 
 import (
+	"fmt"
+	"strings"
 	"testing"
 	"time"
 )
@@ -163,6 +165,18 @@ func TestCell_AsFloat(t *testing.T) {
 			expected:   1e-308,
 			expectedOk: true,
 		},
+		{
+			name:       "percentage text",
+			cell:       Cell{Value: "50%", NumberFormat: "0%"},
+			expected:   0.5,
+			expectedOk: true,
+		},
+		{
+			name:       "percent sign without percentage format",
+			cell:       Cell{Value: "50%"},
+			expected:   0,
+			expectedOk: false,
+		},
 	}
 
 	for _, tt := range tests {
@@ -178,6 +192,35 @@ func TestCell_AsFloat(t *testing.T) {
 	}
 }
 
+func TestCell_AsCurrency(t *testing.T) {
+	tests := []struct {
+		name           string
+		cell           Cell
+		expected       float64
+		expectedSymbol string
+		expectedOk     bool
+	}{
+		{"dollar literal", Cell{Value: 1234.5, NumberFormat: `"$"#,##0.00`}, 1234.5, "$", true},
+		{"euro locale tag", Cell{Value: 9.99, NumberFormat: "[$€-407]#,##0.00"}, 9.99, "€", true},
+		{"pound unquoted", Cell{Value: 3.0, NumberFormat: "£#,##0"}, 3, "£", true},
+		{"date locale tag", Cell{Value: 45000.0, NumberFormat: "[$-409]mmmm d, yyyy"}, 0, "", false},
+		{"plain number", Cell{Value: 42.0, NumberFormat: "0.00"}, 0, "", false},
+		{"currency format on text", Cell{Value: "n/a", NumberFormat: `"$"#,##0.00`}, 0, "", false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, symbol, ok := tt.cell.AsCurrency()
+			if ok != tt.expectedOk {
+				t.Errorf("Cell.AsCurrency() ok = %v, want %v", ok, tt.expectedOk)
+			}
+			if got != tt.expected || symbol != tt.expectedSymbol {
+				t.Errorf("Cell.AsCurrency() = %v, %q, want %v, %q", got, symbol, tt.expected, tt.expectedSymbol)
+			}
+		})
+	}
+}
+
 func TestCell_AsTime(t *testing.T) {
 	now := time.Now()
 	fixedTime := time.Date(2024, 1, 15, 10, 30, 0, 0, time.UTC)
@@ -239,6 +282,35 @@ func TestCell_AsTime(t *testing.T) {
 	}
 }
 
+func TestCell_AsJSON(t *testing.T) {
+	date := time.Date(2024, 1, 15, 10, 30, 0, 0, time.UTC)
+
+	tests := []struct {
+		name     string
+		cell     Cell
+		expected interface{}
+	}{
+		{"number", Cell{Type: CellTypeNumber, Value: 42.5, RawValue: "42.5"}, 42.5},
+		{"bool", Cell{Type: CellTypeBool, Value: true, RawValue: "TRUE"}, true},
+		{"date", Cell{Type: CellTypeDate, Value: date, RawValue: "2024-01-15"}, "2024-01-15T10:30:00Z"},
+		{"string", Cell{Type: CellTypeString, Value: "hello", RawValue: "hello"}, "hello"},
+		{"empty", Cell{Type: CellTypeEmpty}, nil},
+		{"formula without typed value", Cell{Type: CellTypeFormula, RawValue: "10"}, "10"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := tt.cell.AsJSON()
+			if fmt.Sprintf("%T", got) != fmt.Sprintf("%T", tt.expected) {
+				t.Fatalf("AsJSON() type = %T, want %T", got, tt.expected)
+			}
+			if got != tt.expected {
+				t.Errorf("AsJSON() = %v, want %v", got, tt.expected)
+			}
+		})
+	}
+}
+
 func TestCell_IsMergeOrigin(t *testing.T) {
 	tests := []struct {
 		name     string
@@ -376,6 +448,48 @@ func TestRow_Get_EmptyRow(t *testing.T) {
 	}
 }
 
+func TestNewRow_Set(t *testing.T) {
+	row := NewRow(4)
+	row.Set("Name", Cell{Value: "Alice", Type: CellTypeString, RawValue: "Alice"})
+
+	if row.Index != 4 {
+		t.Errorf("Index = %d, want 4", row.Index)
+	}
+	if cell, ok := row.Get("Name"); !ok || cell.RawValue != "Alice" {
+		t.Errorf("Get(Name) = %q, %v, want Alice, true", cell.RawValue, ok)
+	}
+
+	var zero Row
+	zero.Set("Age", Cell{Value: 30.0, Type: CellTypeNumber, RawValue: "30"})
+	if len(zero.Values) != 1 {
+		t.Errorf("Set on a zero Row: len(Values) = %d, want 1", len(zero.Values))
+	}
+}
+
+func TestRow_Clone(t *testing.T) {
+	original := NewRow(1)
+	original.Set("Name", Cell{Value: "Alice", Type: CellTypeString, RawValue: "Alice"})
+	original.Cells = []Cell{original.Values["Name"]}
+
+	clone := original.Clone()
+	clone.Set("Name", Cell{Value: "Bob", Type: CellTypeString, RawValue: "Bob"})
+	clone.Set("Age", Cell{Value: 25.0, Type: CellTypeNumber, RawValue: "25"})
+	clone.Cells[0] = clone.Values["Name"]
+
+	if cell, _ := original.Get("Name"); cell.RawValue != "Alice" {
+		t.Errorf("original Name = %q after mutating clone, want Alice", cell.RawValue)
+	}
+	if _, ok := original.Get("Age"); ok {
+		t.Error("original gained Age after mutating clone")
+	}
+	if original.Cells[0].RawValue != "Alice" {
+		t.Errorf("original Cells[0] = %q after mutating clone, want Alice", original.Cells[0].RawValue)
+	}
+	if clone.Index != 1 {
+		t.Errorf("clone Index = %d, want 1", clone.Index)
+	}
+}
+
 // =============================================================================
 // Table Tests
 // =============================================================================
@@ -712,6 +826,186 @@ func TestTable_Filter_DoesNotModifyOriginal(t *testing.T) {
 // Deduplication Tests
 // =============================================================================
 
+func createIndexedTable(n int) Table {
+	table := Table{
+		Name:      "Indexed",
+		Headers:   []string{"N"},
+		StartRow:  2,
+		EndRow:    2 + n,
+		HeaderRow: 2,
+	}
+	for i := 0; i < n; i++ {
+		table.Rows = append(table.Rows, Row{Index: i, Values: map[string]Cell{"N": {Value: float64(i)}}})
+	}
+	return table
+}
+
+func TestTable_Head(t *testing.T) {
+	table := createIndexedTable(5)
+
+	head := table.Head(2)
+	if head.RowCount() != 2 || head.Rows[0].Index != 0 || head.Rows[1].Index != 1 {
+		t.Errorf("Head(2) returned wrong rows: %+v", head.Rows)
+	}
+	if head.Name != table.Name || head.HeaderRow != table.HeaderRow || head.StartRow != table.StartRow {
+		t.Errorf("Head() did not preserve metadata")
+	}
+}
+
+func TestTable_Tail(t *testing.T) {
+	table := createIndexedTable(5)
+
+	tail := table.Tail(2)
+	if tail.RowCount() != 2 || tail.Rows[0].Index != 3 || tail.Rows[1].Index != 4 {
+		t.Errorf("Tail(2) returned wrong rows: %+v", tail.Rows)
+	}
+	if tail.Name != table.Name || tail.EndRow != table.EndRow {
+		t.Errorf("Tail() did not preserve metadata")
+	}
+}
+
+func TestTable_HeadTail_Clamping(t *testing.T) {
+	table := createIndexedTable(3)
+
+	tests := []struct {
+		name string
+		got  *Table
+		want int
+	}{
+		{"head larger than count", table.Head(10), 3},
+		{"tail larger than count", table.Tail(10), 3},
+		{"head zero", table.Head(0), 0},
+		{"tail zero", table.Tail(0), 0},
+		{"head negative", table.Head(-1), 0},
+		{"tail negative", table.Tail(-1), 0},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if tt.got.RowCount() != tt.want {
+				t.Errorf("RowCount() = %d, want %d", tt.got.RowCount(), tt.want)
+			}
+		})
+	}
+}
+
+func TestTable_Head_DoesNotModifyOriginal(t *testing.T) {
+	table := createIndexedTable(3)
+
+	head := table.Head(1)
+	head.Rows = append(head.Rows, Row{Index: 99})
+
+	if table.RowCount() != 3 || table.Rows[1].Index != 1 {
+		t.Errorf("Original table was modified")
+	}
+}
+
+func TestTable_ColumnValues(t *testing.T) {
+	table := Table{
+		Headers: []string{"Name", "City"},
+		Rows: []Row{
+			{Index: 1, Values: map[string]Cell{
+				"Name": {Type: CellTypeString, Value: "Alice", RawValue: "Alice"},
+				"City": {Type: CellTypeString, Value: "NYC", RawValue: "NYC"},
+			}},
+			{Index: 2, Values: map[string]Cell{
+				"Name": {Type: CellTypeString, Value: "Bob", RawValue: "Bob"},
+			}},
+			{Index: 3, Values: map[string]Cell{
+				"Name": {Type: CellTypeString, Value: "Carol", RawValue: "Carol"},
+				"City": {Type: CellTypeString, Value: "LA", RawValue: "LA"},
+			}},
+		},
+	}
+
+	cells := table.ColumnValues("City")
+	if len(cells) != 3 {
+		t.Fatalf("ColumnValues(City) len = %d, want 3", len(cells))
+	}
+	if !cells[1].IsEmpty() {
+		t.Errorf("ColumnValues(City)[1] = %v, want empty cell for missing value", cells[1].Value)
+	}
+
+	got := strings.Join(table.ColumnStrings("City"), ",")
+	if got != "NYC,,LA" {
+		t.Errorf("ColumnStrings(City) = %q, want %q", got, "NYC,,LA")
+	}
+	if got := strings.Join(table.ColumnStrings("Name"), ","); got != "Alice,Bob,Carol" {
+		t.Errorf("ColumnStrings(Name) = %q, want %q", got, "Alice,Bob,Carol")
+	}
+
+	if table.ColumnValues("Missing") != nil {
+		t.Error("ColumnValues(Missing) should return nil")
+	}
+	if table.ColumnStrings("Missing") != nil {
+		t.Error("ColumnStrings(Missing) should return nil")
+	}
+}
+
+func TestTable_ToRecords(t *testing.T) {
+	table := NewTableBuilder("People").
+		Headers("Name", "Age", "City").
+		AddRow("Alice", 30, "NYC").
+		AddRow("Bob", 25.5).
+		Build()
+
+	records := table.ToRecords(true, "NULL")
+	if len(records) != table.RowCount()+1 {
+		t.Fatalf("ToRecords(true) len = %d, want %d", len(records), table.RowCount()+1)
+	}
+	if got := strings.Join(records[0], ","); got != "Name,Age,City" {
+		t.Errorf("header record = %q, want %q", got, "Name,Age,City")
+	}
+	if got := strings.Join(records[1], ","); got != "Alice,30,NYC" {
+		t.Errorf("records[1] = %q, want %q", got, "Alice,30,NYC")
+	}
+	if got := strings.Join(records[2], ","); got != "Bob,25.5,NULL" {
+		t.Errorf("records[2] = %q, want %q", got, "Bob,25.5,NULL")
+	}
+
+	records = table.ToRecords(false, "")
+	if len(records) != table.RowCount() {
+		t.Fatalf("ToRecords(false) len = %d, want %d", len(records), table.RowCount())
+	}
+	if records[1][2] != "" {
+		t.Errorf("records[1][2] = %q, want empty", records[1][2])
+	}
+}
+
+func TestTable_Slice(t *testing.T) {
+	table := createIndexedTable(10)
+
+	tests := []struct {
+		name       string
+		start, end int
+		wantFirst  int
+		wantCount  int
+	}{
+		{"normal range", 2, 5, 2, 3},
+		{"full range", 0, 10, 0, 10},
+		{"end out of bounds", 8, 20, 8, 2},
+		{"start negative", -3, 2, 0, 2},
+		{"swapped bounds", 5, 2, 2, 3},
+		{"empty range", 4, 4, 0, 0},
+		{"start beyond rows", 15, 20, 0, 0},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			sliced := table.Slice(tt.start, tt.end)
+			if sliced.RowCount() != tt.wantCount {
+				t.Fatalf("RowCount() = %d, want %d", sliced.RowCount(), tt.wantCount)
+			}
+			if tt.wantCount > 0 && sliced.Rows[0].Index != tt.wantFirst {
+				t.Errorf("first row index = %d, want %d", sliced.Rows[0].Index, tt.wantFirst)
+			}
+			if sliced.Name != table.Name || sliced.HeaderRow != table.HeaderRow {
+				t.Errorf("Slice() did not preserve metadata")
+			}
+		})
+	}
+}
+
 func TestTable_FindDuplicates(t *testing.T) {
 	table := Table{
 		Headers: []string{"Email", "Name"},
@@ -866,6 +1160,75 @@ func TestTable_Deduplicate_DoesNotModifyOriginal(t *testing.T) {
 	}
 }
 
+func TestTable_DeduplicateBy_CompositeKey(t *testing.T) {
+	table := Table{
+		Headers: []string{"First", "Last", "City"},
+		Rows: []Row{
+			{Values: map[string]Cell{"First": {RawValue: "John"}, "Last": {RawValue: "Smith"}, "City": {RawValue: "Paris"}}},
+			{Values: map[string]Cell{"First": {RawValue: "John"}, "Last": {RawValue: "Doe"}, "City": {RawValue: "Rome"}}},
+			{Values: map[string]Cell{"First": {RawValue: "John"}, "Last": {RawValue: "Smith"}, "City": {RawValue: "Berlin"}}},
+			{Values: map[string]Cell{"First": {RawValue: "Jane"}, "Last": {RawValue: "Smith"}, "City": {RawValue: "Oslo"}}},
+		},
+	}
+
+	// A single-column key merges all the Johns
+	if got := table.DeduplicateBy("First").RowCount(); got != 2 {
+		t.Errorf("DeduplicateBy(First) rows = %d, want 2", got)
+	}
+
+	deduped := table.DeduplicateBy("First", "Last")
+	if deduped.RowCount() != 3 {
+		t.Fatalf("DeduplicateBy(First, Last) rows = %d, want 3", deduped.RowCount())
+	}
+
+	// First occurrence is kept
+	if cell, _ := deduped.Rows[0].Get("City"); cell.RawValue != "Paris" {
+		t.Errorf("Expected first John Smith (Paris) to be kept, got %s", cell.RawValue)
+	}
+	if cell, _ := deduped.Rows[1].Get("Last"); cell.RawValue != "Doe" {
+		t.Errorf("Expected John Doe second, got %s", cell.RawValue)
+	}
+}
+
+func TestTable_DeduplicateBy_SeparatorAmbiguity(t *testing.T) {
+	table := Table{
+		Headers: []string{"A", "B"},
+		Rows: []Row{
+			{Values: map[string]Cell{"A": {RawValue: "ab"}, "B": {RawValue: "c"}}},
+			{Values: map[string]Cell{"A": {RawValue: "a"}, "B": {RawValue: "bc"}}},
+		},
+	}
+
+	if got := table.DeduplicateBy("A", "B").RowCount(); got != 2 {
+		t.Errorf("Expected concatenation boundaries to be preserved, got %d rows", got)
+	}
+}
+
+func TestTable_DeduplicateBy_PreservesMetadata(t *testing.T) {
+	table := Table{
+		Name:      "OriginalTable",
+		Headers:   []string{"ID", "Name"},
+		HeaderRow: 5,
+		StartRow:  5,
+		Rows: []Row{
+			{Values: map[string]Cell{"ID": {RawValue: "1"}, "Name": {RawValue: "Alice"}}},
+			{Values: map[string]Cell{"ID": {RawValue: "1"}, "Name": {RawValue: "Alice"}}},
+		},
+	}
+
+	deduped := table.DeduplicateBy("ID", "Name")
+
+	if deduped.Name != table.Name || deduped.HeaderRow != table.HeaderRow || deduped.StartRow != table.StartRow {
+		t.Errorf("Metadata not preserved")
+	}
+	if deduped.RowCount() != 1 {
+		t.Errorf("Expected 1 row, got %d", deduped.RowCount())
+	}
+	if table.RowCount() != 2 {
+		t.Errorf("Original table was modified")
+	}
+}
+
 func TestTable_FindDuplicateGroups(t *testing.T) {
 	table := Table{
 		Headers: []string{"Email", "Name"},
@@ -932,6 +1295,86 @@ func TestTable_FindDuplicateGroups_NoDuplicates(t *testing.T) {
 // Column Transformation Tests
 // =============================================================================
 
+func TestTable_FindDuplicateGroupsBy_RowIndices(t *testing.T) {
+	table := Table{
+		Headers: []string{"First", "Last", "Age"},
+		Rows: []Row{
+			{Values: map[string]Cell{"First": {RawValue: "John"}, "Last": {RawValue: "Smith"}, "Age": {RawValue: "30"}}},
+			{Values: map[string]Cell{"First": {RawValue: "John"}, "Last": {RawValue: "Doe"}, "Age": {RawValue: "41"}}},
+			{Values: map[string]Cell{"First": {RawValue: "Jane"}, "Last": {RawValue: "Smith"}, "Age": {RawValue: "25"}}},
+			{Values: map[string]Cell{"First": {RawValue: "John"}, "Last": {RawValue: "Smith"}, "Age": {RawValue: "31"}}},
+		},
+	}
+
+	groups := table.FindDuplicateGroupsBy("First", "Last")
+
+	if len(groups) != 1 {
+		t.Fatalf("Expected 1 duplicate group, got %d", len(groups))
+	}
+
+	group := groups[0]
+	if group.Count != 2 {
+		t.Errorf("Expected count 2, got %d", group.Count)
+	}
+	if len(group.KeyValues) != 2 || group.KeyValues[0] != "John" || group.KeyValues[1] != "Smith" {
+		t.Errorf("KeyValues = %v, want [John Smith]", group.KeyValues)
+	}
+	if len(group.RowIndices) != 2 || group.RowIndices[0] != 0 || group.RowIndices[1] != 3 {
+		t.Fatalf("RowIndices = %v, want [0 3]", group.RowIndices)
+	}
+
+	// Indices point back at the offending rows
+	for _, idx := range group.RowIndices {
+		row := table.Rows[idx]
+		first, _ := row.Get("First")
+		last, _ := row.Get("Last")
+		if first.RawValue != "John" || last.RawValue != "Smith" {
+			t.Errorf("Row %d is %s %s, want John Smith", idx, first.RawValue, last.RawValue)
+		}
+	}
+}
+
+func TestTable_FindDuplicateGroupsBy_OrderedByFirstOccurrence(t *testing.T) {
+	table := Table{
+		Headers: []string{"A", "B"},
+		Rows: []Row{
+			{Values: map[string]Cell{"A": {RawValue: "z"}, "B": {RawValue: "1"}}},
+			{Values: map[string]Cell{"A": {RawValue: "a"}, "B": {RawValue: "1"}}},
+			{Values: map[string]Cell{"A": {RawValue: "a"}, "B": {RawValue: "1"}}},
+			{Values: map[string]Cell{"A": {RawValue: "z"}, "B": {RawValue: "1"}}},
+		},
+	}
+
+	groups := table.FindDuplicateGroupsBy("A", "B")
+
+	if len(groups) != 2 {
+		t.Fatalf("Expected 2 duplicate groups, got %d", len(groups))
+	}
+	if groups[0].KeyValue != "z, 1" || groups[1].KeyValue != "a, 1" {
+		t.Errorf("Unexpected group order: %q, %q", groups[0].KeyValue, groups[1].KeyValue)
+	}
+}
+
+func TestTable_FindDuplicateGroups_RowIndices(t *testing.T) {
+	table := Table{
+		Headers: []string{"ID"},
+		Rows: []Row{
+			{Values: map[string]Cell{"ID": {RawValue: "1"}}},
+			{Values: map[string]Cell{"ID": {RawValue: "2"}}},
+			{Values: map[string]Cell{"ID": {RawValue: "1"}}},
+		},
+	}
+
+	groups := table.FindDuplicateGroups("ID")
+
+	if len(groups) != 1 {
+		t.Fatalf("Expected 1 duplicate group, got %d", len(groups))
+	}
+	if len(groups[0].RowIndices) != 2 || groups[0].RowIndices[0] != 0 || groups[0].RowIndices[1] != 2 {
+		t.Errorf("RowIndices = %v, want [0 2]", groups[0].RowIndices)
+	}
+}
+
 func TestTable_Select(t *testing.T) {
 	table := Table{
 		Name:    "TestTable",
@@ -970,6 +1413,41 @@ func TestTable_Select(t *testing.T) {
 	}
 }
 
+func TestTable_HeaderCells(t *testing.T) {
+	table := Table{
+		Headers: []string{"ID", "Name", "Email"},
+		HeaderCells: []Cell{
+			{RawValue: "ID", Row: 3, Col: 2},
+			{RawValue: "Name", Row: 3, Col: 3},
+			{RawValue: "Email", Row: 3, Col: 4},
+		},
+	}
+
+	cell, ok := table.HeaderCell("Name")
+	if !ok || cell.Row != 3 || cell.Col != 3 {
+		t.Errorf("HeaderCell(Name) = (%d, %d), %v, want (3, 3), true", cell.Row, cell.Col, ok)
+	}
+	if _, ok := table.HeaderCell("Missing"); ok {
+		t.Error("HeaderCell(Missing) should not be found")
+	}
+
+	// Column operations keep header cells aligned with headers
+	selected := table.Select("Email", "ID")
+	if len(selected.HeaderCells) != 2 || selected.HeaderCells[0].Col != 4 || selected.HeaderCells[1].Col != 2 {
+		t.Errorf("Select() HeaderCells = %v, want cols [4 2]", selected.HeaderCells)
+	}
+
+	renamed := table.Rename(map[string]string{"Email": "Mail"})
+	if cell, ok := renamed.HeaderCell("Mail"); !ok || cell.Col != 4 {
+		t.Errorf("Rename() HeaderCell(Mail).Col = %d, want 4", cell.Col)
+	}
+
+	filtered := table.Filter(func(Row) bool { return true })
+	if len(filtered.HeaderCells) != 3 {
+		t.Errorf("Filter() len(HeaderCells) = %d, want 3", len(filtered.HeaderCells))
+	}
+}
+
 func TestTable_Select_InvalidColumn(t *testing.T) {
 	table := Table{
 		Headers: []string{"ID", "Name"},
@@ -1024,6 +1502,205 @@ func TestTable_Select_PreservesMetadata(t *testing.T) {
 	}
 }
 
+func TestTable_DropColumns(t *testing.T) {
+	table := Table{
+		Headers: []string{"ID", "Name", "Notes", "Internal"},
+		Rows: []Row{
+			{Values: map[string]Cell{
+				"ID":       {RawValue: "1"},
+				"Name":     {RawValue: "Alice"},
+				"Notes":    {RawValue: "call back"},
+				"Internal": {RawValue: "x"},
+			}},
+		},
+	}
+
+	dropped := table.DropColumns("Notes", "Internal", "Missing")
+
+	if len(dropped.Headers) != 2 || dropped.Headers[0] != "ID" || dropped.Headers[1] != "Name" {
+		t.Errorf("DropColumns() headers = %v, want [ID Name]", dropped.Headers)
+	}
+	if _, ok := dropped.Rows[0].Get("Notes"); ok {
+		t.Error("Notes column should not be present")
+	}
+	if cell, ok := dropped.Rows[0].Get("Name"); !ok || cell.RawValue != "Alice" {
+		t.Errorf("Get(Name) = %q, %v, want Alice", cell.RawValue, ok)
+	}
+}
+
+func TestTable_Enrich(t *testing.T) {
+	orders := NewTableBuilder("Orders").
+		Headers("OrderID", "CustomerID", "Amount").
+		AddRow("O1", "C1", 100).
+		AddRow("O2", "C2", 250).
+		AddRow("O3", "C9", 75).
+		AddRow("O4", "C1", 30).
+		Build()
+	customers := NewTableBuilder("Customers").
+		Headers("CustomerID", "Name", "Country").
+		AddRow("C1", "Acme", "US").
+		AddRow("C2", "Globex", "DE").
+		Build()
+
+	enriched := orders.Enrich(customers, "CustomerID", "Name")
+	if enriched.RowCount() != orders.RowCount() {
+		t.Fatalf("RowCount() = %d, want %d", enriched.RowCount(), orders.RowCount())
+	}
+	if got := strings.Join(enriched.Headers, ","); got != "OrderID,CustomerID,Amount,Name" {
+		t.Errorf("Headers = %q, want %q", got, "OrderID,CustomerID,Amount,Name")
+	}
+	want := []string{"Acme", "Globex", "", "Acme"}
+	for i, name := range enriched.ColumnStrings("Name") {
+		if name != want[i] {
+			t.Errorf("row %d Name = %q, want %q", i, name, want[i])
+		}
+	}
+	if cell := enriched.Rows[2].Values["Name"]; !cell.IsEmpty() {
+		t.Errorf("unmatched Name = %v, want empty cell", cell.Value)
+	}
+	if cell := enriched.Rows[1].Cells[3]; cell.AsString() != "Globex" {
+		t.Errorf("Cells[3] = %q, want Globex", cell.AsString())
+	}
+	if len(orders.Headers) != 3 || len(orders.Rows[0].Cells) != 3 {
+		t.Error("Enrich modified the original table")
+	}
+
+	all := orders.Enrich(customers, "CustomerID")
+	if got := strings.Join(all.Headers, ","); got != "OrderID,CustomerID,Amount,Name,Country" {
+		t.Errorf("Headers with no columns named = %q", got)
+	}
+}
+
+func TestTable_FillDown(t *testing.T) {
+	cell := func(v string) Cell {
+		if v == "" {
+			return Cell{Type: CellTypeEmpty}
+		}
+		return Cell{Value: v, Type: CellTypeString, RawValue: v}
+	}
+	categories := []string{"Fruit", "", "", "Veg", ""}
+	items := []string{"Apple", "Pear", "", "Leek", "Kale"}
+
+	table := Table{Headers: []string{"Category", "Item"}}
+	for i := range categories {
+		c, it := cell(categories[i]), cell(items[i])
+		table.Rows = append(table.Rows, Row{
+			Index:  i,
+			Values: map[string]Cell{"Category": c, "Item": it},
+			Cells:  []Cell{c, it},
+		})
+	}
+
+	filled := table.FillDown("Category")
+
+	want := []string{"Fruit", "Fruit", "Fruit", "Veg", "Veg"}
+	for i, w := range want {
+		if got, _ := filled.Rows[i].Get("Category"); got.AsString() != w {
+			t.Errorf("row %d Category = %q, want %q", i, got.AsString(), w)
+		}
+		if got := filled.Rows[i].Cells[0]; got.AsString() != w {
+			t.Errorf("row %d Cells[0] = %q, want %q", i, got.AsString(), w)
+		}
+	}
+	// Unlisted columns and the source table are left alone
+	if item, _ := filled.Rows[2].Get("Item"); !item.IsEmpty() {
+		t.Errorf("Item in row 2 = %q, want empty", item.RawValue)
+	}
+	if orig, _ := table.Rows[1].Get("Category"); !orig.IsEmpty() {
+		t.Errorf("source table was modified: %q", orig.RawValue)
+	}
+
+	// With no columns every column is filled
+	if item, _ := table.FillDown().Rows[2].Get("Item"); item.AsString() != "Pear" {
+		t.Errorf("FillDown() Item in row 2 = %q, want Pear", item.AsString())
+	}
+}
+
+func TestInferValue(t *testing.T) {
+	tests := []struct {
+		raw  string
+		want CellType
+	}{
+		{"", CellTypeEmpty},
+		{"TRUE", CellTypeBool},
+		{"12.5", CellTypeNumber},
+		{"2023-01-15", CellTypeDate},
+		{"Jan 15, 2023", CellTypeDate},
+		{"hello", CellTypeString},
+	}
+	for _, tt := range tests {
+		if _, got := InferValue(tt.raw); got != tt.want {
+			t.Errorf("InferValue(%q) type = %v, want %v", tt.raw, got, tt.want)
+		}
+	}
+
+	if tm, ok := ParseDate("01/15/2023"); !ok || tm.Day() != 15 || tm.Month() != time.January {
+		t.Errorf("ParseDate(01/15/2023) = %v, %v, want 2023-01-15", tm, ok)
+	}
+	if _, ok := ParseDate("soon"); ok {
+		t.Error("ParseDate(soon) should fail")
+	}
+}
+
+func TestTable_ReplaceValues(t *testing.T) {
+	table := Table{
+		Headers: []string{"Score", "Active"},
+		Rows: []Row{
+			{Values: map[string]Cell{
+				"Score":  {Value: "N/A", Type: CellTypeString, RawValue: "N/A"},
+				"Active": {Value: "Y", Type: CellTypeString, RawValue: "Y"},
+			}},
+			{Values: map[string]Cell{
+				"Score":  {Value: 42.0, Type: CellTypeNumber, RawValue: "42"},
+				"Active": {Value: "N/A", Type: CellTypeString, RawValue: "N/A"},
+			}},
+		},
+	}
+
+	replaced := table.ReplaceValues("Active", map[string]string{"Y": "true", "N": "false"})
+
+	active, _ := replaced.Rows[0].Get("Active")
+	if active.Type != CellTypeBool || active.Value != true {
+		t.Errorf("Active = %v (%v), want bool true", active.Value, active.Type)
+	}
+	if score, _ := replaced.Rows[0].Get("Score"); score.RawValue != "N/A" {
+		t.Errorf("Score = %q, want N/A left alone in another column", score.RawValue)
+	}
+	if orig, _ := table.Rows[0].Get("Active"); orig.RawValue != "Y" {
+		t.Errorf("source table was modified: Active = %q", orig.RawValue)
+	}
+}
+
+func TestTable_ReplaceAllValues(t *testing.T) {
+	table := Table{
+		Headers: []string{"Score", "Note"},
+		Rows: []Row{
+			{Values: map[string]Cell{
+				"Score": {Value: "N/A", Type: CellTypeString, RawValue: "N/A"},
+				"Note":  {Value: "N/A", Type: CellTypeString, RawValue: "N/A"},
+			}},
+			{Values: map[string]Cell{
+				"Score": {Value: "-", Type: CellTypeString, RawValue: "-"},
+				"Note":  {Value: "ok", Type: CellTypeString, RawValue: "ok"},
+			}},
+		},
+	}
+
+	replaced := table.ReplaceAllValues(map[string]string{"N/A": "", "-": "0"})
+
+	for _, col := range []string{"Score", "Note"} {
+		if cell, _ := replaced.Rows[0].Get(col); !cell.IsEmpty() || cell.Value != nil {
+			t.Errorf("%s = %v, want an empty cell", col, cell.Value)
+		}
+	}
+	if score, _ := replaced.Rows[1].Get("Score"); score.Type != CellTypeNumber || score.Value != 0.0 {
+		t.Errorf("Score = %v (%v), want number 0", score.Value, score.Type)
+	}
+	if note, _ := replaced.Rows[1].Get("Note"); note.RawValue != "ok" {
+		t.Errorf("Note = %q, want ok", note.RawValue)
+	}
+}
+
 func TestTable_Rename(t *testing.T) {
 	table := Table{
 		Headers: []string{"old_name", "old_email"},
@@ -1053,6 +1730,42 @@ func TestTable_Rename(t *testing.T) {
 	}
 }
 
+func TestTable_WithHeaders(t *testing.T) {
+	table := Table{
+		Headers: []string{"Column_1", "Column_2"},
+		Rows: []Row{
+			{Values: map[string]Cell{
+				"Column_1": {RawValue: "Alice"},
+				"Column_2": {RawValue: "alice@test.com"},
+			}},
+		},
+	}
+
+	named, err := table.WithHeaders([]string{"Name", "Email"})
+	if err != nil {
+		t.Fatalf("WithHeaders() error = %v", err)
+	}
+	if named.Headers[0] != "Name" || named.Headers[1] != "Email" {
+		t.Errorf("WithHeaders() headers = %v, want [Name Email]", named.Headers)
+	}
+	if cell, ok := named.Rows[0].Get("Email"); !ok || cell.RawValue != "alice@test.com" {
+		t.Errorf("Get(Email) = %v, %v, want alice@test.com", cell.RawValue, ok)
+	}
+	if _, ok := named.Rows[0].Get("Column_1"); ok {
+		t.Error("Get(Column_1) found a value after renaming")
+	}
+	if table.Headers[0] != "Column_1" {
+		t.Errorf("original headers changed to %v", table.Headers)
+	}
+
+	if _, err := table.WithHeaders([]string{"Name"}); err == nil {
+		t.Error("WithHeaders() with too few headers should return an error")
+	}
+	if _, err := table.WithHeaders([]string{"Name", "Name"}); err == nil {
+		t.Error("WithHeaders() with duplicate headers should return an error")
+	}
+}
+
 func TestTable_Rename_PartialMapping(t *testing.T) {
 	table := Table{
 		Headers: []string{"ID", "old_name", "Status"},
@@ -1096,6 +1809,275 @@ func TestTable_Rename_DoesNotModifyOriginal(t *testing.T) {
 	}
 }
 
+func TestTable_RenameFunc(t *testing.T) {
+	table := Table{
+		Headers: []string{"First Name", "Email"},
+		Rows: []Row{
+			{Values: map[string]Cell{
+				"First Name": {RawValue: "Alice"},
+				"Email":      {RawValue: "alice@test.com"},
+			}},
+		},
+	}
+
+	renamed := table.RenameFunc(strings.ToLower)
+
+	if renamed.Headers[0] != "first name" || renamed.Headers[1] != "email" {
+		t.Errorf("Headers = %v, want [first name email]", renamed.Headers)
+	}
+	if cell, ok := renamed.Rows[0].Get("first name"); !ok || cell.RawValue != "Alice" {
+		t.Errorf("Expected 'first name' = Alice")
+	}
+	if _, ok := renamed.Rows[0].Get("First Name"); ok {
+		t.Errorf("Old column name should not exist")
+	}
+	if table.Headers[0] != "First Name" {
+		t.Errorf("Original table was modified")
+	}
+}
+
+func TestTable_RenameFunc_Collision(t *testing.T) {
+	table := Table{
+		Headers: []string{"Name", "NAME", "name"},
+		Rows: []Row{
+			{Values: map[string]Cell{
+				"Name": {RawValue: "a"},
+				"NAME": {RawValue: "b"},
+				"name": {RawValue: "c"},
+			}},
+		},
+	}
+
+	renamed := table.RenameFunc(strings.ToLower)
+
+	want := []string{"name", "name_2", "name_3"}
+	for i, h := range want {
+		if renamed.Headers[i] != h {
+			t.Errorf("Headers[%d] = %q, want %q", i, renamed.Headers[i], h)
+		}
+	}
+	for i, h := range want {
+		cell, ok := renamed.Rows[0].Get(h)
+		if !ok || cell.RawValue != string(rune('a'+i)) {
+			t.Errorf("Get(%q) = %q, want %q", h, cell.RawValue, string(rune('a'+i)))
+		}
+	}
+}
+
+func TestTable_Transpose(t *testing.T) {
+	table := Table{
+		Name:    "People",
+		Headers: []string{"Name", "Age", "City"},
+		Rows: []Row{
+			{Index: 1, Values: map[string]Cell{
+				"Name": {Type: CellTypeString, Value: "Alice", RawValue: "Alice"},
+				"Age":  {Type: CellTypeNumber, Value: 30.0, RawValue: "30"},
+				"City": {Type: CellTypeString, Value: "NYC", RawValue: "NYC"},
+			}},
+			{Index: 2, Values: map[string]Cell{
+				"Name": {Type: CellTypeString, Value: "Bob", RawValue: "Bob"},
+				"Age":  {Type: CellTypeNumber, Value: 25.0, RawValue: "25"},
+				"City": {Type: CellTypeString, Value: "LA", RawValue: "LA"},
+			}},
+		},
+	}
+
+	transposed := table.Transpose()
+
+	if transposed.RowCount() != 3 || len(transposed.Headers) != 2 {
+		t.Fatalf("Transpose() = %dx%d, want 3x2", transposed.RowCount(), len(transposed.Headers))
+	}
+	if transposed.Headers[0] != "Alice" || transposed.Headers[1] != "Bob" {
+		t.Errorf("Headers = %v, want [Alice Bob]", transposed.Headers)
+	}
+	if !transposed.Transposed {
+		t.Error("Transposed flag should be set")
+	}
+
+	// Every original column is a row, the first one included
+	if cell, _ := transposed.Rows[0].Get("Bob"); cell.RawValue != "Bob" {
+		t.Errorf("Rows[0][Bob] = %q, want Bob", cell.RawValue)
+	}
+	cell, ok := transposed.Rows[1].Get("Bob")
+	if !ok || cell.RawValue != "25" || cell.Type != CellTypeNumber {
+		t.Errorf("Rows[1][Bob] = %q (type %v), want number 25", cell.RawValue, cell.Type)
+	}
+	if cell, _ := transposed.Rows[2].Get("Alice"); cell.RawValue != "NYC" {
+		t.Errorf("Rows[2][Alice] = %q, want NYC", cell.RawValue)
+	}
+	if len(transposed.Rows[0].Cells) != 2 {
+		t.Errorf("len(Rows[0].Cells) = %d, want 2", len(transposed.Rows[0].Cells))
+	}
+}
+
+func TestTable_Transpose_Bounds(t *testing.T) {
+	table := Table{Headers: []string{"Key", "Value"}, StartRow: 2, EndRow: 9, StartCol: 1, EndCol: 2}
+
+	transposed := table.Transpose()
+
+	if transposed.StartRow != 1 || transposed.EndRow != 2 || transposed.StartCol != 2 || transposed.EndCol != 9 {
+		t.Errorf("bounds = rows %d-%d, cols %d-%d, want rows 1-2, cols 2-9",
+			transposed.StartRow, transposed.EndRow, transposed.StartCol, transposed.EndCol)
+	}
+}
+
+func TestWorkbook_MergeAndConcatTables(t *testing.T) {
+	newTable := func(name, id string) Table {
+		return Table{
+			Name:    name,
+			Headers: []string{"ID"},
+			Rows:    []Row{{Index: 1, Values: map[string]Cell{"ID": {Type: CellTypeString, Value: id, RawValue: id}}}},
+		}
+	}
+	q1 := &Workbook{FilePath: "q1.xlsx", Sheets: []Sheet{{Name: "Sales", Tables: []Table{newTable("Sales_Table1", "a")}}}}
+	q2 := &Workbook{FilePath: "q2.xlsx", Sheets: []Sheet{{Name: "Sales", Tables: []Table{newTable("Sales_Table1", "b"), newTable("Other", "c")}}}}
+
+	merged := q1.Merge(q2)
+
+	if len(merged.Sheets) != 2 {
+		t.Fatalf("len(Sheets) = %d, want 2", len(merged.Sheets))
+	}
+	if merged.Sheets[0].SourceFile != "q1.xlsx" || merged.Sheets[1].SourceFile != "q2.xlsx" {
+		t.Errorf("SourceFile = %q, %q, want q1.xlsx, q2.xlsx", merged.Sheets[0].SourceFile, merged.Sheets[1].SourceFile)
+	}
+	if q1.Sheets[0].SourceFile != "" {
+		t.Error("Merge() should not modify the original workbook")
+	}
+
+	sales := merged.ConcatTables("Sales_Table1")
+	if sales == nil {
+		t.Fatal("ConcatTables() returned nil")
+	}
+	if sales.RowCount() != 2 {
+		t.Fatalf("RowCount() = %d, want 2", sales.RowCount())
+	}
+	first, _ := sales.Rows[0].Get("ID")
+	second, _ := sales.Rows[1].Get("ID")
+	if first.AsString() != "a" || second.AsString() != "b" {
+		t.Errorf("IDs = %q, %q, want a, b", first.AsString(), second.AsString())
+	}
+
+	if merged.ConcatTables("Missing") != nil {
+		t.Error("ConcatTables() for unknown name should return nil")
+	}
+}
+
+func TestWorkbook_FindCrossSheetDuplicates(t *testing.T) {
+	newTable := func(name string, emails ...string) Table {
+		table := Table{Name: name, Headers: []string{"Email"}}
+		for i, email := range emails {
+			row := NewRow(i + 1)
+			row.Set("Email", Cell{Type: CellTypeString, Value: email, RawValue: email})
+			table.Rows = append(table.Rows, row)
+		}
+		return table
+	}
+	wb := &Workbook{Sheets: []Sheet{
+		{Name: "January", Tables: []Table{newTable("Jan", "alice@test.com", "bob@test.com", "bob@test.com")}},
+		{Name: "February", Tables: []Table{newTable("Feb", "carol@test.com", "alice@test.com")}},
+		{Name: "Notes", Tables: []Table{{Name: "Other", Headers: []string{"Note"}}}},
+	}}
+
+	dups := wb.FindCrossSheetDuplicates("Email")
+
+	if len(dups) != 1 {
+		t.Fatalf("FindCrossSheetDuplicates() = %v, want only alice@test.com", dups)
+	}
+	want := []Location{{Sheet: "January", Table: "Jan", Row: 0}, {Sheet: "February", Table: "Feb", Row: 1}}
+	got := dups["alice@test.com"]
+	if len(got) != len(want) || got[0] != want[0] || got[1] != want[1] {
+		t.Errorf("locations = %+v, want %+v", got, want)
+	}
+}
+
+func TestTable_Transpose_GeneratedHeaders(t *testing.T) {
+	table := Table{
+		Headers: []string{"Key", "Value"},
+		Rows: []Row{
+			{Values: map[string]Cell{"Key": {RawValue: "a"}, "Value": {RawValue: "1"}}},
+			{Values: map[string]Cell{"Key": {RawValue: ""}, "Value": {RawValue: "2"}}},
+			{Values: map[string]Cell{"Key": {RawValue: "a"}, "Value": {RawValue: "3"}}},
+		},
+	}
+
+	transposed := table.Transpose()
+
+	want := []string{"a", "Column_2", "a_2"}
+	for i, h := range want {
+		if transposed.Headers[i] != h {
+			t.Errorf("Headers[%d] = %q, want %q", i, transposed.Headers[i], h)
+		}
+	}
+}
+
+func TestTable_Unpivot(t *testing.T) {
+	table := NewTableBuilder("Sales").
+		Headers("Name", "Jan", "Feb", "Mar").
+		AddRow("Alice", 10, 20, 30).
+		AddRow("Bob", 5, nil, 15).
+		Build()
+
+	long := table.Unpivot([]string{"Name"}, []string{"Jan", "Feb", "Mar"}, "Month", "Value")
+
+	wantHeaders := []string{"Name", "Month", "Value"}
+	if len(long.Headers) != len(wantHeaders) {
+		t.Fatalf("Headers = %v, want %v", long.Headers, wantHeaders)
+	}
+	for i, h := range wantHeaders {
+		if long.Headers[i] != h {
+			t.Errorf("Headers[%d] = %q, want %q", i, long.Headers[i], h)
+		}
+	}
+
+	want := [][]string{
+		{"Alice", "Jan", "10"}, {"Alice", "Feb", "20"}, {"Alice", "Mar", "30"},
+		{"Bob", "Jan", "5"}, {"Bob", "Feb", ""}, {"Bob", "Mar", "15"},
+	}
+	if len(long.Rows) != len(want) {
+		t.Fatalf("Rows = %d, want %d", len(long.Rows), len(want))
+	}
+	for i, row := range long.Rows {
+		for c, h := range wantHeaders {
+			cell := row.Values[h]
+			if got := cell.AsString(); got != want[i][c] {
+				t.Errorf("Rows[%d][%s] = %q, want %q", i, h, got, want[i][c])
+			}
+		}
+		if len(row.Cells) != len(wantHeaders) {
+			t.Errorf("Rows[%d] has %d cells, want %d", i, len(row.Cells), len(wantHeaders))
+		}
+	}
+	if long.Rows[0].Values["Value"].Type != CellTypeNumber {
+		t.Errorf("Value type = %v, want number", long.Rows[0].Values["Value"].Type)
+	}
+	if table.ColCount() != 4 || table.RowCount() != 2 {
+		t.Errorf("source table changed: %v x %d", table.Headers, table.RowCount())
+	}
+}
+
+func TestTable_Unpivot_Defaults(t *testing.T) {
+	table := NewTableBuilder("Sales").
+		Headers("Name", "Jan", "Feb").
+		AddRow("Alice", 10, 20).
+		Build()
+
+	long := table.Unpivot([]string{"Name", "Missing"}, nil, "", "")
+
+	wantHeaders := []string{"Name", "variable", "value"}
+	for i, h := range wantHeaders {
+		if i >= len(long.Headers) || long.Headers[i] != h {
+			t.Fatalf("Headers = %v, want %v", long.Headers, wantHeaders)
+		}
+	}
+	if len(long.Rows) != 2 {
+		t.Fatalf("Rows = %d, want 2", len(long.Rows))
+	}
+	variable := long.Rows[1].Values["variable"]
+	if got := variable.AsString(); got != "Feb" {
+		t.Errorf("Rows[1][variable] = %q, want Feb", got)
+	}
+}
+
 func TestTable_Reorder(t *testing.T) {
 	table := Table{
 		Headers: []string{"ID", "Name", "Email", "Age"},
@@ -1651,6 +2633,130 @@ func TestTable_AnalyzeColumns_NumericStats_Decimals(t *testing.T) {
 // DetectionConfig Tests
 // =============================================================================
 
+func TestTable_AnalyzeColumns_DetectedFormat(t *testing.T) {
+	values := map[string][]string{
+		"Email": {"alice@example.com", "bob@test.org", "carol@mail.co.uk"},
+		"Site":  {"https://example.com", "http://test.org/page", "https://go.dev/doc"},
+		"UUID":  {"123e4567-e89b-12d3-a456-426614174000", "550e8400-e29b-41d4-a716-446655440000", "6ba7b810-9dad-11d1-80b4-00c04fd430c8"},
+		"Date":  {"2024-01-15", "2024-02-20T10:30:00", "2023-12-31"},
+		"ID":    {"1001", "1002", "1003"},
+		"Phone": {"+1 (555) 123-4567", "555-987-6543", "+44 20 7946 0958"},
+		"Mixed": {"alice@example.com", "not an email", "12345"},
+	}
+	headers := []string{"Email", "Site", "UUID", "Date", "ID", "Phone", "Mixed"}
+
+	table := Table{Headers: headers}
+	for i := 0; i < 3; i++ {
+		row := Row{Values: make(map[string]Cell)}
+		for _, h := range headers {
+			row.Values[h] = Cell{Value: values[h][i], Type: CellTypeString, RawValue: values[h][i]}
+		}
+		table.Rows = append(table.Rows, row)
+	}
+
+	stats := table.AnalyzeColumns()
+
+	expected := []string{FormatEmail, FormatURL, FormatUUID, FormatISODate, FormatIntegerID, FormatPhone, ""}
+	for i, want := range expected {
+		if stats[i].DetectedFormat != want {
+			t.Errorf("%s: DetectedFormat = %q, want %q", stats[i].Name, stats[i].DetectedFormat, want)
+		}
+	}
+}
+
+func TestTable_AnalyzeColumnsWithOptions_FormatThreshold(t *testing.T) {
+	table := Table{
+		Headers: []string{"Email"},
+		Rows: []Row{
+			{Values: map[string]Cell{"Email": {Value: "a@example.com", Type: CellTypeString, RawValue: "a@example.com"}}},
+			{Values: map[string]Cell{"Email": {Value: "b@example.com", Type: CellTypeString, RawValue: "b@example.com"}}},
+			{Values: map[string]Cell{"Email": {Value: "c@example.com", Type: CellTypeString, RawValue: "c@example.com"}}},
+			{Values: map[string]Cell{"Email": {Value: "n/a", Type: CellTypeString, RawValue: "n/a"}}},
+			{Values: map[string]Cell{"Email": {Type: CellTypeEmpty}}},
+		},
+	}
+
+	// 3 of 4 non-empty values match: below the default threshold
+	if got := table.AnalyzeColumns()[0].DetectedFormat; got != "" {
+		t.Errorf("default threshold: DetectedFormat = %q, want empty", got)
+	}
+
+	opts := DefaultAnalyzeOptions()
+	opts.FormatThreshold = 0.75
+	if got := table.AnalyzeColumnsWithOptions(opts)[0].DetectedFormat; got != FormatEmail {
+		t.Errorf("threshold 0.75: DetectedFormat = %q, want %q", got, FormatEmail)
+	}
+
+	opts.FormatThreshold = 0
+	if got := table.AnalyzeColumnsWithOptions(opts)[0].DetectedFormat; got != "" {
+		t.Errorf("threshold 0: DetectedFormat = %q, want empty", got)
+	}
+}
+
+func TestTable_AnalyzeColumns_DetectedFormat_AllEmpty(t *testing.T) {
+	table := Table{
+		Headers: []string{"Empty"},
+		Rows: []Row{
+			{Values: map[string]Cell{"Empty": {Type: CellTypeEmpty}}},
+		},
+	}
+
+	if got := table.AnalyzeColumns()[0].DetectedFormat; got != "" {
+		t.Errorf("DetectedFormat = %q, want empty", got)
+	}
+}
+
+func TestTable_AnalyzeColumns_TopValues(t *testing.T) {
+	statuses := []string{"active", "pending", "active", "inactive", "active", "pending"}
+	table := Table{Headers: []string{"Status"}}
+	for _, s := range statuses {
+		table.Rows = append(table.Rows, Row{
+			Values: map[string]Cell{"Status": {Value: s, Type: CellTypeString, RawValue: s}},
+		})
+	}
+	table.Rows = append(table.Rows, Row{Values: map[string]Cell{"Status": {Type: CellTypeEmpty}}})
+
+	stats := table.AnalyzeColumns()
+	top := stats[0].TopValues
+
+	expected := []ValueCount{
+		{Value: "active", Count: 3},
+		{Value: "pending", Count: 2},
+		{Value: "inactive", Count: 1},
+	}
+	if len(top) != len(expected) {
+		t.Fatalf("len(TopValues) = %d, want %d", len(top), len(expected))
+	}
+	for i, want := range expected {
+		if top[i] != want {
+			t.Errorf("TopValues[%d] = %+v, want %+v", i, top[i], want)
+		}
+	}
+}
+
+func TestTable_AnalyzeColumnsWithOptions_TopN(t *testing.T) {
+	table := Table{Headers: []string{"Code"}}
+	for _, s := range []string{"b", "a", "c", "a", "b", "d"} {
+		table.Rows = append(table.Rows, Row{
+			Values: map[string]Cell{"Code": {Value: s, Type: CellTypeString, RawValue: s}},
+		})
+	}
+
+	opts := DefaultAnalyzeOptions()
+	opts.TopN = 2
+	top := table.AnalyzeColumnsWithOptions(opts)[0].TopValues
+
+	// Ties are broken alphabetically
+	if len(top) != 2 || top[0].Value != "a" || top[1].Value != "b" {
+		t.Errorf("TopValues = %+v, want [a b]", top)
+	}
+
+	opts.TopN = 0
+	if top := table.AnalyzeColumnsWithOptions(opts)[0].TopValues; top != nil {
+		t.Errorf("TopN=0: TopValues = %+v, want nil", top)
+	}
+}
+
 func TestDefaultConfig(t *testing.T) {
 	config := DefaultConfig()
 
@@ -2155,3 +3261,58 @@ func TestDiffTables_KeyColumn(t *testing.T) {
 		t.Errorf("Expected 1 modified row using Email as key")
 	}
 }
+
+func TestTable_SortBy(t *testing.T) {
+	table := NewTableBuilder("People").
+		Headers("Name", "Age").
+		AddRow("Alice", 9).
+		AddRow("Bob", 10).
+		AddRow("Charlie", nil).
+		AddRow("Dana", 9).
+		Build()
+
+	names := func(tbl *Table) string { return strings.Join(tbl.ColumnStrings("Name"), ",") }
+
+	if got := names(table.SortBy("Age", true)); got != "Charlie,Alice,Dana,Bob" {
+		t.Errorf("ascending = %s, want Charlie,Alice,Dana,Bob", got)
+	}
+	if got := names(table.SortBy("Age", false)); got != "Bob,Alice,Dana,Charlie" {
+		t.Errorf("descending = %s, want Bob,Alice,Dana,Charlie", got)
+	}
+	if got := names(table); got != "Alice,Bob,Charlie,Dana" {
+		t.Errorf("original = %s, want it unchanged", got)
+	}
+}
+
+func TestTable_Coalesce(t *testing.T) {
+	table := NewTableBuilder("Contacts").
+		Headers("Name", "Phone1", "Phone2", "Phone3").
+		AddRow("Alice", "555-0101", "555-0102", nil).
+		AddRow("Bob", nil, "555-0202", "555-0203").
+		AddRow("Charlie", nil, nil, nil).
+		Build()
+
+	result := table.Coalesce("Phone", "Phone1", "Phone2", "Phone3", "Fax")
+
+	if got := strings.Join(result.Headers, ","); got != "Name,Phone1,Phone2,Phone3,Phone" {
+		t.Errorf("Headers = %s, want the sources kept and Phone appended", got)
+	}
+	if got := strings.Join(result.ColumnStrings("Phone"), ","); got != "555-0101,555-0202," {
+		t.Errorf("Phone = %s, want 555-0101,555-0202,", got)
+	}
+	bob := result.Rows[1]
+	if len(bob.Cells) != 5 || bob.Cells[4].AsString() != "555-0202" {
+		t.Errorf("Bob Cells = %v, want Phone2's value last", bob.Cells)
+	}
+	if len(result.HeaderCells) != 5 || result.HeaderCells[4].AsString() != "Phone" {
+		t.Errorf("HeaderCells = %v, want a Phone header cell", result.HeaderCells)
+	}
+	if len(table.Headers) != 4 || len(table.Rows[1].Cells) != 4 {
+		t.Error("Coalesce modified the original table")
+	}
+
+	inPlace := table.Coalesce("Phone1", "Phone1", "Phone2")
+	if got := strings.Join(inPlace.ColumnStrings("Phone1"), ","); got != "555-0101,555-0202," || len(inPlace.Headers) != 4 {
+		t.Errorf("in place Phone1 = %s with %d headers, want 555-0101,555-0202, and 4 headers", got, len(inPlace.Headers))
+	}
+}
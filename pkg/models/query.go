@@ -0,0 +1,152 @@
+package models
+
+import (
+	"fmt"
+	"strings"
+)
+
+// QueryBuilder builds a query over a table from Where, Select, OrderBy and
+// Limit clauses. Clauses can be given in any order; Result applies the
+// conditions first, then the ordering, the limit and finally the selection.
+type QueryBuilder struct {
+	table      *Table
+	conditions []RowPredicate
+	columns    []string
+	orders     []queryOrder
+	limit      int
+	err        error
+}
+
+// queryOrder is a single OrderBy clause
+type queryOrder struct {
+	column    string
+	ascending bool
+}
+
+// Query starts a query over the table.
+//
+//	adults, err := table.Query().
+//	    Where("Age", ">=", 18).
+//	    Where("Name", "startsWith", "A").
+//	    Select("Name", "Age").
+//	    OrderBy("Age", false).
+//	    Limit(10).
+//	    Result()
+func (t *Table) Query() *QueryBuilder {
+	return &QueryBuilder{table: t, limit: -1}
+}
+
+// Where keeps rows whose column value matches value under op. Comparison
+// operators are =, !=, <, <=, > and >=; numbers and dates compare by value as
+// in Cell.Compare, and empty cells never match an ordering comparison.
+// String operators are contains, startsWith and endsWith, matched against
+// the cell's string value. Multiple Where clauses must all match.
+func (q *QueryBuilder) Where(column, op string, value interface{}) *QueryBuilder {
+	if q.err != nil {
+		return q
+	}
+	if !q.table.hasHeader(column) {
+		q.err = fmt.Errorf("where: column %s not found", column)
+		return q
+	}
+	match, err := queryOperator(op, value)
+	if err != nil {
+		q.err = err
+		return q
+	}
+	q.conditions = append(q.conditions, func(row Row) bool {
+		return match(row.Values[column])
+	})
+	return q
+}
+
+// Select limits the result to the given columns, as Table.Select does
+func (q *QueryBuilder) Select(columns ...string) *QueryBuilder {
+	q.columns = columns
+	return q
+}
+
+// OrderBy sorts the result by a column. Later clauses break ties in earlier ones.
+func (q *QueryBuilder) OrderBy(column string, ascending bool) *QueryBuilder {
+	if q.err != nil {
+		return q
+	}
+	if !q.table.hasHeader(column) {
+		q.err = fmt.Errorf("order by: column %s not found", column)
+		return q
+	}
+	q.orders = append(q.orders, queryOrder{column: column, ascending: ascending})
+	return q
+}
+
+// Limit keeps at most n rows of the result
+func (q *QueryBuilder) Limit(n int) *QueryBuilder {
+	q.limit = n
+	return q
+}
+
+// Result runs the query and returns the result as a new table, or the first
+// error from an unknown column or operator
+func (q *QueryBuilder) Result() (*Table, error) {
+	if q.err != nil {
+		return nil, q.err
+	}
+
+	result := q.table.Filter(func(row Row) bool {
+		for _, cond := range q.conditions {
+			if !cond(row) {
+				return false
+			}
+		}
+		return true
+	})
+	// Stable sorts applied from the last clause to the first order by all of them
+	for i := len(q.orders) - 1; i >= 0; i-- {
+		result = result.SortBy(q.orders[i].column, q.orders[i].ascending)
+	}
+	if q.limit >= 0 {
+		result = result.Head(q.limit)
+	}
+	if q.columns != nil {
+		result = result.Select(q.columns...)
+	}
+	return result, nil
+}
+
+// queryOperator returns a function matching cells against value under op
+func queryOperator(op string, value interface{}) (func(Cell) bool, error) {
+	target := cellFromValue(value)
+	text := fmt.Sprint(value)
+
+	ordered := func(accept func(int) bool) func(Cell) bool {
+		return func(cell Cell) bool {
+			if cell.blank() || target.blank() {
+				return false
+			}
+			return accept(cell.Compare(target))
+		}
+	}
+
+	switch op {
+	case "=", "==":
+		return func(cell Cell) bool { return cell.Equal(target) }, nil
+	case "!=", "<>":
+		return func(cell Cell) bool { return !cell.Equal(target) }, nil
+	case "<":
+		return ordered(func(c int) bool { return c < 0 }), nil
+	case "<=":
+		return ordered(func(c int) bool { return c <= 0 }), nil
+	case ">":
+		return ordered(func(c int) bool { return c > 0 }), nil
+	case ">=":
+		return ordered(func(c int) bool { return c >= 0 }), nil
+	case "contains":
+		return func(cell Cell) bool { return strings.Contains(cell.AsString(), text) }, nil
+	case "startsWith":
+		return func(cell Cell) bool { return strings.HasPrefix(cell.AsString(), text) }, nil
+	case "endsWith":
+		return func(cell Cell) bool { return strings.HasSuffix(cell.AsString(), text) }, nil
+	default:
+		return nil, fmt.Errorf("unknown query operator: %q", op)
+	}
+}
@@ -0,0 +1,125 @@
+package models
+
+import (
+	"reflect"
+	"strings"
+	"testing"
+	"time"
+)
+
+func createQueryTestTable() *Table {
+	return NewTableBuilder("People").
+		Headers("Name", "Age", "City", "Joined").
+		AddRow("Alice", 30, "New York", time.Date(2021, 3, 1, 0, 0, 0, 0, time.UTC)).
+		AddRow("Bob", 17, "Newark", time.Date(2022, 6, 15, 0, 0, 0, 0, time.UTC)).
+		AddRow("Anna", 45, "Boston", time.Date(2019, 1, 10, 0, 0, 0, 0, time.UTC)).
+		AddRow("Andre", 22, "New Haven", time.Date(2023, 9, 5, 0, 0, 0, 0, time.UTC)).
+		AddRow("Carl", nil, "New York", time.Date(2020, 2, 2, 0, 0, 0, 0, time.UTC)).
+		AddRow("Amy", 30, "Austin", time.Date(2018, 4, 4, 0, 0, 0, 0, time.UTC)).
+		Build()
+}
+
+func TestQuery_MultipleClauses(t *testing.T) {
+	result, err := createQueryTestTable().Query().
+		Where("Age", ">", 18).
+		Where("Name", "startsWith", "A").
+		Select("Name", "Age").
+		OrderBy("Age", false).
+		OrderBy("Name", true).
+		Limit(3).
+		Result()
+	if err != nil {
+		t.Fatalf("Result() error = %v", err)
+	}
+
+	if !reflect.DeepEqual(result.Headers, []string{"Name", "Age"}) {
+		t.Errorf("Headers = %v, want [Name Age]", result.Headers)
+	}
+	if got := strings.Join(result.ColumnStrings("Name"), ","); got != "Anna,Alice,Amy" {
+		t.Errorf("Names = %s, want Anna,Alice,Amy", got)
+	}
+}
+
+func TestQuery_MatchesChainedOperations(t *testing.T) {
+	table := createQueryTestTable()
+
+	result, err := table.Query().
+		Where("City", "contains", "New").
+		Where("Age", ">=", 18).
+		OrderBy("Age", true).
+		Limit(2).
+		Select("Name", "City").
+		Result()
+	if err != nil {
+		t.Fatalf("Result() error = %v", err)
+	}
+
+	chained := table.Filter(func(row Row) bool {
+		city, _ := row.Get("City")
+		age, ok := row.Get("Age")
+		if !ok {
+			return false
+		}
+		v, ok := age.AsFloat()
+		return strings.Contains(city.AsString(), "New") && ok && v >= 18
+	}).SortBy("Age", true).Head(2).Select("Name", "City")
+
+	if !reflect.DeepEqual(result, chained) {
+		t.Errorf("Query() = %v, want %v", result.ToRecords(true, ""), chained.ToRecords(true, ""))
+	}
+}
+
+func TestQuery_Operators(t *testing.T) {
+	jan2021 := time.Date(2021, 1, 1, 0, 0, 0, 0, time.UTC)
+
+	tests := []struct {
+		column string
+		op     string
+		value  interface{}
+		want   string
+	}{
+		{"Age", "=", 30, "Alice,Amy"},
+		{"Age", "!=", 30, "Bob,Anna,Andre,Carl"},
+		{"Age", "<", 22, "Bob"},
+		{"Age", "<=", 22, "Bob,Andre"},
+		{"Joined", ">", jan2021, "Alice,Bob,Andre"},
+		{"Joined", "<", jan2021, "Anna,Carl,Amy"},
+		{"City", "contains", "ew", "Alice,Bob,Andre,Carl"},
+		{"City", "startsWith", "B", "Anna"},
+		{"City", "endsWith", "York", "Alice,Carl"},
+	}
+
+	table := createQueryTestTable()
+	for _, tt := range tests {
+		t.Run(tt.column+" "+tt.op, func(t *testing.T) {
+			result, err := table.Query().Where(tt.column, tt.op, tt.value).Result()
+			if err != nil {
+				t.Fatalf("Result() error = %v", err)
+			}
+			if got := strings.Join(result.ColumnStrings("Name"), ","); got != tt.want {
+				t.Errorf("Names = %s, want %s", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestQuery_Errors(t *testing.T) {
+	table := createQueryTestTable()
+
+	tests := []struct {
+		name  string
+		query *QueryBuilder
+	}{
+		{"unknown where column", table.Query().Where("Salary", ">", 1)},
+		{"unknown operator", table.Query().Where("Age", "~", 1)},
+		{"unknown order column", table.Query().OrderBy("Salary", true)},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if _, err := tt.query.Result(); err == nil {
+				t.Error("Result() error = nil, want an error")
+			}
+		})
+	}
+}
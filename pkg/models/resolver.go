@@ -0,0 +1,120 @@
+package models
+
+import (
+	"fmt"
+	"strings"
+	"unicode"
+)
+
+// Match scores, best first. Partial matches score below scoreNormalized by
+// how much of the longer name the shorter one covers.
+const (
+	scoreExact      = 4.0
+	scoreFold       = 3.0
+	scoreNormalized = 2.0
+)
+
+// ColumnResolver matches loosely written column names ("email", "E-mail",
+// "email_address") to a table's actual headers. Names are compared exactly,
+// then case-insensitively, then in normalized form with spaces and punctuation
+// removed, and finally by one normalized name containing the other.
+type ColumnResolver struct {
+	headers    []string
+	normalized []string
+}
+
+// NewColumnResolver creates a resolver for the given headers
+func NewColumnResolver(headers []string) *ColumnResolver {
+	r := &ColumnResolver{
+		headers:    headers,
+		normalized: make([]string, len(headers)),
+	}
+	for i, h := range headers {
+		r.normalized[i] = normalizeColumnName(h)
+	}
+	return r
+}
+
+// Resolve returns the header that best matches name. It errors if nothing
+// matches or if two headers match equally well.
+func (r *ColumnResolver) Resolve(name string) (string, error) {
+	target := normalizeColumnName(name)
+	best := -1
+	bestScore := 0.0
+	ambiguous := false
+
+	for i := range r.headers {
+		score := r.score(name, target, i)
+		switch {
+		case score == 0:
+			continue
+		case score > bestScore:
+			best, bestScore, ambiguous = i, score, false
+		case score == bestScore:
+			ambiguous = true
+		}
+	}
+
+	if best < 0 {
+		return "", fmt.Errorf("column not found: %s", name)
+	}
+	if ambiguous {
+		return "", fmt.Errorf("ambiguous column: %s", name)
+	}
+	return r.headers[best], nil
+}
+
+// ResolveAll resolves each name and returns the matching headers in the same order
+func (r *ColumnResolver) ResolveAll(names ...string) ([]string, error) {
+	resolved := make([]string, 0, len(names))
+	for _, name := range names {
+		h, err := r.Resolve(name)
+		if err != nil {
+			return nil, err
+		}
+		resolved = append(resolved, h)
+	}
+	return resolved, nil
+}
+
+// score rates how well name matches the header at index i; 0 means no match
+func (r *ColumnResolver) score(name, target string, i int) float64 {
+	header, normalized := r.headers[i], r.normalized[i]
+	switch {
+	case header == name:
+		return scoreExact
+	case strings.EqualFold(header, name):
+		return scoreFold
+	case target == "" || normalized == "":
+		return 0
+	case normalized == target:
+		return scoreNormalized
+	case strings.Contains(normalized, target):
+		return float64(len(target)) / float64(len(normalized))
+	case strings.Contains(target, normalized):
+		return float64(len(normalized)) / float64(len(target))
+	}
+	return 0
+}
+
+// normalizeColumnName lowercases a name and drops everything but letters and digits
+func normalizeColumnName(name string) string {
+	var b strings.Builder
+	for _, r := range name {
+		if unicode.IsLetter(r) || unicode.IsDigit(r) {
+			b.WriteRune(unicode.ToLower(r))
+		}
+	}
+	return b.String()
+}
+
+// SelectFuzzy is like Select but resolves each name with a ColumnResolver, so
+// "email" selects a header such as "E-mail Address". The result uses the
+// table's own header names.
+func (t *Table) SelectFuzzy(names ...string) (*Table, error) {
+	columns, err := NewColumnResolver(t.Headers).ResolveAll(names...)
+	if err != nil {
+		return nil, err
+	}
+	return t.Select(columns...), nil
+}
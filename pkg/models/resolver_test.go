@@ -0,0 +1,73 @@
+package models
+
+import "testing"
+
+func TestColumnResolver_Resolve(t *testing.T) {
+	r := NewColumnResolver([]string{"ID", "Full Name", "E-mail Address", "Email Verified Flag", "phone_number"})
+
+	tests := []struct {
+		name     string
+		input    string
+		expected string
+		wantErr  bool
+	}{
+		{"exact", "ID", "ID", false},
+		{"case-insensitive", "full name", "Full Name", false},
+		{"normalized", "PhoneNumber", "phone_number", false},
+		{"normalized punctuation", "e_mail address", "E-mail Address", false},
+		{"partial picks best score", "email", "E-mail Address", false},
+		{"query contains header", "customer_id", "ID", false},
+		{"no match", "Salary", "", true},
+		{"empty", "", "", true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := r.Resolve(tt.input)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("Resolve(%q) error = %v, wantErr %v", tt.input, err, tt.wantErr)
+			}
+			if got != tt.expected {
+				t.Errorf("Resolve(%q) = %q, want %q", tt.input, got, tt.expected)
+			}
+		})
+	}
+}
+
+func TestColumnResolver_Ambiguous(t *testing.T) {
+	r := NewColumnResolver([]string{"Email Home", "Email Work"})
+	if got, err := r.Resolve("email"); err == nil {
+		t.Errorf("Resolve(email) = %q, want an ambiguity error", got)
+	}
+	if got, err := r.Resolve("email work"); err != nil || got != "Email Work" {
+		t.Errorf("Resolve(email work) = %q, %v, want Email Work", got, err)
+	}
+}
+
+func TestTable_SelectFuzzy(t *testing.T) {
+	table := Table{
+		Headers: []string{"ID", "Full Name", "E-mail Address"},
+		Rows: []Row{
+			{Values: map[string]Cell{
+				"ID":             {RawValue: "1"},
+				"Full Name":      {RawValue: "Alice"},
+				"E-mail Address": {RawValue: "alice@test.com"},
+			}},
+		},
+	}
+
+	selected, err := table.SelectFuzzy("email", "full_name")
+	if err != nil {
+		t.Fatalf("SelectFuzzy() error = %v", err)
+	}
+	if len(selected.Headers) != 2 || selected.Headers[0] != "E-mail Address" || selected.Headers[1] != "Full Name" {
+		t.Errorf("SelectFuzzy() headers = %v, want [E-mail Address Full Name]", selected.Headers)
+	}
+	if cell, ok := selected.Rows[0].Get("E-mail Address"); !ok || cell.RawValue != "alice@test.com" {
+		t.Errorf("Get(E-mail Address) = %q, %v, want alice@test.com", cell.RawValue, ok)
+	}
+
+	if _, err := table.SelectFuzzy("salary"); err == nil {
+		t.Error("SelectFuzzy() with an unknown column should return an error")
+	}
+}
@@ -73,28 +73,13 @@ func (ta *TableAnalyzer) expandTable(grid [][]models.Cell, startRow, startCol in
 		}
 	}
 
-	// Find the right boundary
-	rightCol := startCol
-	consecutiveEmpty := 0
-	for col := startCol + 1; col < maxCols; col++ {
-		hasData := false
-		// Check multiple rows to determine if column has data
-		for row := startRow; row < minInt(startRow+10, maxRows); row++ {
-			if row < len(grid) && col < len(grid[row]) && !grid[row][col].IsEmpty() {
-				hasData = true
-				break
-			}
-		}
-		if hasData {
-			rightCol = col
-			consecutiveEmpty = 0
-		} else {
-			consecutiveEmpty++
-			if consecutiveEmpty > 1 {
-				break
-			}
-		}
-	}
+	// Find the right boundary from the first rows; it is narrowed to the
+	// table's own rows once its end is known
+	rightCol := ta.scanRightCol(grid, startRow, startRow+9, startCol, maxCols)
+
+	// The contiguous rows under the start cell give the width blocks after a
+	// gap are compared with
+	blockRight := ta.scanRightCol(grid, startRow, ta.blockEnd(grid, startRow, leftCol, maxCols), startCol, maxCols)
 
 	// Find the bottom boundary
 	endRow := startRow
@@ -108,6 +93,18 @@ func (ta *TableAnalyzer) expandTable(grid [][]models.Cell, startRow, startCol in
 			}
 		}
 		if rowHasData {
+			// A wide enough gap before this row means it starts another table
+			if ta.config.TableSeparatorRows > 0 && emptyRowCount >= ta.config.TableSeparatorRows {
+				break
+			}
+			// So does a block after a gap that is wider, or narrower by more
+			// than one sparse trailing column
+			if emptyRowCount > 0 {
+				next := ta.scanRightCol(grid, row, ta.blockEnd(grid, row, leftCol, maxCols), leftCol, maxCols)
+				if next > blockRight || next < blockRight-1 {
+					break
+				}
+			}
 			endRow = row
 			emptyRowCount = 0
 		} else {
@@ -118,6 +115,14 @@ func (ta *TableAnalyzer) expandTable(grid [][]models.Cell, startRow, startCol in
 		}
 	}
 
+	// Take the width from the table's own first rows, not from a table below it
+	rightCol = ta.scanRightCol(grid, startRow, minInt(startRow+9, endRow), startCol, maxCols)
+
+	// Widen to columns with data anywhere in the table, not just its first rows
+	if ta.config.KeepTrailingEmptyColumns {
+		rightCol = ta.scanRightCol(grid, startRow, endRow, rightCol, maxCols)
+	}
+
 	boundary := models.TableBoundary{
 		StartRow: startRow,
 		EndRow:   endRow,
@@ -140,6 +145,52 @@ func (ta *TableAnalyzer) expandTable(grid [][]models.Cell, startRow, startCol in
 	return boundary
 }
 
+// scanRightCol returns the last column with data in rows firstRow..lastRow,
+// scanning right from fromCol until two consecutive empty columns
+func (ta *TableAnalyzer) scanRightCol(grid [][]models.Cell, firstRow, lastRow, fromCol, maxCols int) int {
+	rightCol := fromCol
+	consecutiveEmpty := 0
+	for col := fromCol + 1; col < maxCols; col++ {
+		hasData := false
+		for row := firstRow; row <= lastRow && row < len(grid); row++ {
+			if col < len(grid[row]) && !grid[row][col].IsEmpty() {
+				hasData = true
+				break
+			}
+		}
+		if hasData {
+			rightCol = col
+			consecutiveEmpty = 0
+		} else {
+			consecutiveEmpty++
+			if consecutiveEmpty > 1 {
+				break
+			}
+		}
+	}
+	return rightCol
+}
+
+// blockEnd returns the last row of the contiguous non-empty rows starting at
+// startRow, looking at most 10 rows ahead
+func (ta *TableAnalyzer) blockEnd(grid [][]models.Cell, startRow, leftCol, maxCols int) int {
+	end := startRow
+	for row := startRow + 1; row < minInt(startRow+10, len(grid)); row++ {
+		rowHasData := false
+		for col := leftCol; col < maxCols && col < len(grid[row]); col++ {
+			if !grid[row][col].IsEmpty() {
+				rowHasData = true
+				break
+			}
+		}
+		if !rowHasData {
+			break
+		}
+		end = row
+	}
+	return end
+}
+
 // expandForMerges ensures merged cells don't get cut off at table boundaries
 func (ta *TableAnalyzer) expandForMerges(grid [][]models.Cell, boundary models.TableBoundary) models.TableBoundary {
 	expanded := boundary
@@ -273,6 +324,81 @@ func (ta *TableAnalyzer) merge(a, b models.TableBoundary) models.TableBoundary {
 	}
 }
 
+// IsTransposed reports whether a region reads better with headers down its first
+// column than across its first row. Each orientation is scored on how header-like
+// its first line is and how consistent the cell types are within each field.
+func (ta *TableAnalyzer) IsTransposed(grid [][]models.Cell, boundary models.TableBoundary) bool {
+	transposed, tBoundary := TransposeRegion(grid, boundary)
+	return ta.orientationScore(transposed, tBoundary) > ta.orientationScore(grid, boundary)
+}
+
+// orientationScore scores a region read with its first row as headers
+func (ta *TableAnalyzer) orientationScore(grid [][]models.Cell, boundary models.TableBoundary) float64 {
+	hd := NewHeaderDetector(ta.config)
+	return hd.scoreAsHeader(grid, boundary.StartRow, boundary) + ta.typeConsistency(grid, boundary)*50
+}
+
+// typeConsistency returns the average share of each column's data cells
+// (below the first row) that have the column's most common type
+func (ta *TableAnalyzer) typeConsistency(grid [][]models.Cell, boundary models.TableBoundary) float64 {
+	total := 0.0
+	columns := 0
+
+	for col := boundary.StartCol; col <= boundary.EndCol; col++ {
+		counts := make(map[models.CellType]int)
+		nonEmpty := 0
+		for row := boundary.StartRow + 1; row <= boundary.EndRow && row < len(grid); row++ {
+			if col >= len(grid[row]) || grid[row][col].IsEmpty() {
+				continue
+			}
+			counts[grid[row][col].Type]++
+			nonEmpty++
+		}
+		if nonEmpty == 0 {
+			continue
+		}
+
+		dominant := 0
+		for _, n := range counts {
+			if n > dominant {
+				dominant = n
+			}
+		}
+		total += float64(dominant) / float64(nonEmpty)
+		columns++
+	}
+
+	if columns == 0 {
+		return 0
+	}
+	return total / float64(columns)
+}
+
+// TransposeRegion returns the cells of a boundary with rows and columns swapped,
+// along with the boundary of the new grid. Cells keep their original Row and Col.
+func TransposeRegion(grid [][]models.Cell, boundary models.TableBoundary) ([][]models.Cell, models.TableBoundary) {
+	rows := boundary.EndRow - boundary.StartRow + 1
+	cols := boundary.EndCol - boundary.StartCol + 1
+	if rows <= 0 || cols <= 0 {
+		return nil, models.TableBoundary{}
+	}
+
+	transposed := make([][]models.Cell, cols)
+	for c := 0; c < cols; c++ {
+		transposed[c] = make([]models.Cell, rows)
+		for r := 0; r < rows; r++ {
+			row, col := boundary.StartRow+r, boundary.StartCol+c
+			if row < len(grid) && col < len(grid[row]) {
+				transposed[c][r] = grid[row][col]
+			} else {
+				transposed[c][r] = models.Cell{Type: models.CellTypeEmpty, Row: row, Col: col}
+			}
+		}
+	}
+
+	return transposed, models.TableBoundary{StartRow: 0, EndRow: cols - 1, StartCol: 0, EndCol: rows - 1}
+}
+
 func minInt(a, b int) int {
 	if a < b {
 		return a
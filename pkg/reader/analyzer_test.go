@@ -1,6 +1,7 @@
 package reader
 
 import (
+	"fmt"
 	"testing"
 
 	"github.com/meddhiazoghlami/goxls/pkg/models"
@@ -181,6 +182,56 @@ func TestTableAnalyzer_DetectTables_MultipleTables(t *testing.T) {
 	}
 }
 
+func TestTableAnalyzer_DetectTables_ColumnCountChangeAcrossGap(t *testing.T) {
+	ta := NewDefaultAnalyzer()
+
+	// A 2-column table, one blank row, then a 3-column table. The gap is within
+	// MaxEmptyRows, so only the change in column count separates them.
+	grid := makeGrid(8, 3, func(row, col int) models.Cell {
+		if row <= 3 && col <= 1 {
+			return makeCell("table1", models.CellTypeString)
+		}
+		if row >= 5 {
+			return makeCell("table2", models.CellTypeString)
+		}
+		return makeEmptyCell()
+	})
+
+	tables := ta.DetectTables(grid)
+
+	if len(tables) != 2 {
+		t.Fatalf("DetectTables() returned %d tables, want 2: %+v", len(tables), tables)
+	}
+	want := []models.TableBoundary{
+		{StartRow: 0, EndRow: 3, StartCol: 0, EndCol: 1},
+		{StartRow: 5, EndRow: 7, StartCol: 0, EndCol: 2},
+	}
+	for i, w := range want {
+		if tables[i] != w {
+			t.Errorf("tables[%d] = %+v, want %+v", i, tables[i], w)
+		}
+	}
+}
+
+func TestTableAnalyzer_DetectTables_SparseTrailingColumnAcrossGap(t *testing.T) {
+	ta := NewDefaultAnalyzer()
+
+	// Rows after the blank row leave the last column empty: one table
+	grid := makeGrid(6, 3, func(row, col int) models.Cell {
+		if row == 3 || (row >= 4 && col == 2) {
+			return makeEmptyCell()
+		}
+		return makeCell("data", models.CellTypeString)
+	})
+
+	tables := ta.DetectTables(grid)
+
+	want := models.TableBoundary{StartRow: 0, EndRow: 5, StartCol: 0, EndCol: 2}
+	if len(tables) != 1 || tables[0] != want {
+		t.Errorf("DetectTables() = %+v, want [%+v]", tables, want)
+	}
+}
+
 func TestTableAnalyzer_DetectTables_TooSmall(t *testing.T) {
 	ta := NewTableAnalyzer(models.DetectionConfig{
 		MinColumns:   3,
@@ -248,6 +299,90 @@ func TestTableAnalyzer_DetectTables_LargeGap(t *testing.T) {
 	}
 }
 
+func TestTableAnalyzer_DetectTables_TableSeparatorRows(t *testing.T) {
+	// Two tables separated by 3 empty rows, which MaxEmptyRows alone tolerates
+	grid := makeGrid(10, 3, func(row, col int) models.Cell {
+		if row <= 2 || (row >= 6 && row <= 8) {
+			return makeCell("data", models.CellTypeString)
+		}
+		return makeEmptyCell()
+	})
+
+	tests := []struct {
+		name      string
+		separator int
+		want      int
+	}{
+		{"disabled", 0, 1},
+		{"gap exceeds separator", 2, 2},
+		{"gap equals separator", 3, 2},
+		{"gap below separator", 4, 1},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ta := NewTableAnalyzer(models.DetectionConfig{
+				MinColumns:         2,
+				MinRows:            2,
+				MaxEmptyRows:       5,
+				TableSeparatorRows: tt.separator,
+			})
+
+			tables := ta.DetectTables(grid)
+			if len(tables) != tt.want {
+				t.Fatalf("DetectTables() returned %d tables, want %d", len(tables), tt.want)
+			}
+			if tt.want == 2 && (tables[0].EndRow != 2 || tables[1].StartRow != 6) {
+				t.Errorf("tables = %+v, want rows 0-2 and 6-8", tables)
+			}
+		})
+	}
+}
+
+func TestTransposeRegion(t *testing.T) {
+	grid := makeGrid(3, 4, func(row, col int) models.Cell {
+		return makeCell(fmt.Sprintf("r%dc%d", row, col), models.CellTypeString)
+	})
+	boundary := models.TableBoundary{StartRow: 1, EndRow: 2, StartCol: 1, EndCol: 3}
+
+	transposed, tb := TransposeRegion(grid, boundary)
+
+	if len(transposed) != 3 || len(transposed[0]) != 2 {
+		t.Fatalf("TransposeRegion() size = %dx%d, want 3x2", len(transposed), len(transposed[0]))
+	}
+	if tb.EndRow != 2 || tb.EndCol != 1 {
+		t.Errorf("boundary = %+v, want EndRow 2, EndCol 1", tb)
+	}
+	if transposed[2][1].RawValue != "r2c3" {
+		t.Errorf("transposed[2][1] = %q, want r2c3", transposed[2][1].RawValue)
+	}
+}
+
+func TestTableAnalyzer_IsTransposed(t *testing.T) {
+	ta := NewDefaultAnalyzer()
+	num := func(v string) models.Cell { return makeCell(v, models.CellTypeNumber) }
+	str := func(v string) models.Cell { return makeCell(v, models.CellTypeString) }
+	boundary := models.TableBoundary{StartRow: 0, EndRow: 2, StartCol: 0, EndCol: 2}
+
+	transposed := [][]models.Cell{
+		{str("Name"), str("Alice"), str("Bob")},
+		{str("Age"), num("30"), num("25")},
+		{str("City"), str("NYC"), str("LA")},
+	}
+	if !ta.IsTransposed(transposed, boundary) {
+		t.Error("IsTransposed() = false for headers in first column")
+	}
+
+	normal := [][]models.Cell{
+		{str("ID"), str("Name"), str("Age")},
+		{num("1"), str("Alice"), num("30")},
+		{num("2"), str("Bob"), num("25")},
+	}
+	if ta.IsTransposed(normal, boundary) {
+		t.Error("IsTransposed() = true for headers in first row")
+	}
+}
+
 // =============================================================================
 // isValidTable Tests
 // =============================================================================
@@ -195,6 +195,31 @@ func BenchmarkReadSheet_WithMergedCells(b *testing.B) {
 	}
 }
 
+func BenchmarkReadSheet_Streaming(b *testing.B) {
+	path := createBenchmarkFile(b, 10000, 20)
+	ef, _ := LoadFile(path)
+	defer ef.Close()
+
+	for _, streaming := range []bool{false, true} {
+		config := models.DefaultConfig()
+		config.StreamingSheetRead = streaming
+		sp := NewSheetProcessorWithConfig(ef, config)
+
+		name := "GetRows"
+		if streaming {
+			name = "RowsIterator"
+		}
+		b.Run(name, func(b *testing.B) {
+			b.ReportAllocs()
+			for i := 0; i < b.N; i++ {
+				if _, err := sp.ReadSheet("Sheet1"); err != nil {
+					b.Fatal(err)
+				}
+			}
+		})
+	}
+}
+
 // =============================================================================
 // Table Detection Benchmarks
 // =============================================================================
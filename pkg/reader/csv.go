@@ -0,0 +1,233 @@
+package reader
+
+import (
+	"bufio"
+	"bytes"
+	"encoding/csv"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/meddhiazoghlami/goxls/pkg/models"
+)
+
+// CSVReadOptions configures reading delimited text files
+type CSVReadOptions struct {
+	Delimiter rune   // Field separator; 0 detects it with SniffDelimiter, falling back to a comma
+	Comment   rune   // Lines starting with this character are skipped; 0 disables comments
+	NoHeader  bool   // If true, the first line is data and columns are named Column_1, Column_2, ...
+	TrimSpace bool   // If true, leading and trailing spaces are removed from every field
+	Name      string // Table name; empty uses the file name without its extension
+}
+
+// ReadCSV reads a CSV file into a table. Each field's type is inferred from
+// its text as for untyped sheet cells, so numbers, booleans and dates get
+// typed values and all table operations and exporters work as for a sheet.
+// Empty lines are skipped and short lines are padded with empty cells.
+func ReadCSV(path string, opts CSVReadOptions) (*models.Table, error) {
+	f, err := os.Open(path)
+	if err != nil {
+		return nil, fmt.Errorf("failed to open file: %w", err)
+	}
+	defer f.Close()
+
+	if opts.Name == "" {
+		opts.Name = strings.TrimSuffix(filepath.Base(path), filepath.Ext(path))
+	}
+	return ReadCSVFrom(f, opts)
+}
+
+// ReadTSV reads a tab-separated file into a table, as ReadCSV does
+func ReadTSV(path string, opts CSVReadOptions) (*models.Table, error) {
+	opts.Delimiter = '\t'
+	return ReadCSV(path, opts)
+}
+
+// ReadCSVFrom reads delimited text from r into a table, as ReadCSV does
+func ReadCSVFrom(r io.Reader, opts CSVReadOptions) (*models.Table, error) {
+	delimiter := opts.Delimiter
+	if delimiter == 0 {
+		br := bufio.NewReaderSize(r, sniffSampleSize)
+		sample, _ := br.Peek(sniffSampleSize)
+		if delimiter, _ = SniffDelimiter(sample); delimiter == 0 {
+			delimiter = ','
+		}
+		r = br
+	}
+
+	cr := csv.NewReader(r)
+	cr.Comma = delimiter
+	cr.Comment = opts.Comment
+	cr.FieldsPerRecord = -1
+	cr.LazyQuotes = true
+
+	records, err := cr.ReadAll()
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse csv: %w", err)
+	}
+	return recordsToTable(records, opts), nil
+}
+
+// recordsToTable converts parsed records into a table with typed cells
+func recordsToTable(records [][]string, opts CSVReadOptions) *models.Table {
+	width := 0
+	for _, record := range records {
+		width = max(width, len(record))
+	}
+
+	grid := make([][]models.Cell, len(records))
+	for r, record := range records {
+		grid[r] = make([]models.Cell, width)
+		for c := range grid[r] {
+			var value string
+			if c < len(record) {
+				value = record[c]
+			}
+			if opts.TrimSpace {
+				value = strings.TrimSpace(value)
+			}
+			cellType := inferType(value)
+			grid[r][c] = models.Cell{
+				Value:    parseValue(value, cellType),
+				Type:     cellType,
+				RawValue: value,
+				Row:      r,
+				Col:      c,
+			}
+		}
+	}
+
+	table := &models.Table{
+		Name:      opts.Name,
+		StartRow:  0,
+		EndRow:    len(grid) - 1,
+		StartCol:  0,
+		EndCol:    width - 1,
+		HeaderRow: 0,
+	}
+	if len(grid) == 0 || width == 0 {
+		table.Headers, table.Rows = []string{}, []models.Row{}
+		table.EndRow, table.EndCol = 0, 0
+		return table
+	}
+
+	boundary := models.TableBoundary{StartRow: 0, EndRow: len(grid) - 1, StartCol: 0, EndCol: width - 1}
+	if opts.NoHeader {
+		table.HeaderRow = -1
+		table.Headers = make([]string, width)
+		table.HeaderCells = make([]models.Cell, width)
+		for c := range table.Headers {
+			table.Headers[c] = fmt.Sprintf("Column_%d", c+1)
+			table.HeaderCells[c] = models.Cell{Value: table.Headers[c], Type: models.CellTypeString, RawValue: table.Headers[c], Row: -1, Col: c}
+		}
+	} else {
+		table.Headers = NewDefaultHeaderDetector().ExtractHeaders(grid, 0, boundary)
+		table.HeaderCells = grid[0]
+	}
+
+	table.Rows = NewDefaultRowParser().ParseRows(grid, table.Headers, table.HeaderRow, boundary)
+	if table.Rows == nil {
+		table.Rows = []models.Row{}
+	}
+	return table
+}
+
+// ErrDelimiterNotDetected is returned by SniffDelimiter when no candidate
+// delimiter, or more than one, fits the sample
+var ErrDelimiterNotDetected = errors.New("cannot detect csv delimiter")
+
+// sniffDelimiters are the candidates SniffDelimiter chooses from, in order of preference
+var sniffDelimiters = []rune{',', ';', '\t', '|'}
+
+const (
+	sniffSampleSize = 64 * 1024 // Bytes ReadCSV reads ahead to detect the delimiter
+	sniffMaxLines   = 10        // Lines of the sample SniffDelimiter looks at
+)
+
+// SniffDelimiter detects the field delimiter of delimited text from a sample
+// of its first lines. Comma, semicolon, tab and pipe are counted on each line,
+// outside double quotes, and the delimiter is the one with the same count on
+// the most lines, then the highest count. An incomplete last line is ignored.
+// It returns ErrDelimiterNotDetected if no candidate occurs or two fit the
+// sample equally well.
+func SniffDelimiter(sample []byte) (rune, error) {
+	lines := sniffSampleLines(sample)
+	if len(lines) == 0 {
+		return 0, fmt.Errorf("%w: sample is empty", ErrDelimiterNotDetected)
+	}
+
+	var best rune
+	bestLines, bestCount, tied := 0, 0, false
+	for _, d := range sniffDelimiters {
+		count, matching := delimiterMode(lines, d)
+		if count == 0 {
+			continue
+		}
+		switch {
+		case matching > bestLines || matching == bestLines && count > bestCount:
+			best, bestLines, bestCount, tied = d, matching, count, false
+		case matching == bestLines && count == bestCount:
+			tied = true
+		}
+	}
+
+	if best == 0 {
+		return 0, fmt.Errorf("%w: no delimiter found", ErrDelimiterNotDetected)
+	}
+	if tied {
+		return 0, fmt.Errorf("%w: sample is ambiguous", ErrDelimiterNotDetected)
+	}
+	return best, nil
+}
+
+// sniffSampleLines returns the non-empty lines of a sample, without a final
+// line cut off by the end of the sample
+func sniffSampleLines(sample []byte) [][]byte {
+	lines := bytes.Split(sample, []byte("\n"))
+	if len(lines) > 1 {
+		// The last element is empty after a final newline, or a partial line otherwise
+		lines = lines[:len(lines)-1]
+	}
+
+	result := make([][]byte, 0, sniffMaxLines)
+	for _, line := range lines {
+		line = bytes.TrimRight(line, "\r")
+		if len(bytes.TrimSpace(line)) == 0 {
+			continue
+		}
+		result = append(result, line)
+		if len(result) == sniffMaxLines {
+			break
+		}
+	}
+	return result
+}
+
+// delimiterMode returns the most common non-zero number of times d occurs on
+// a line outside quotes, and how many lines have that count
+func delimiterMode(lines [][]byte, d rune) (count, matching int) {
+	counts := make(map[int]int)
+	for _, line := range lines {
+		n, quoted := 0, false
+		for _, r := range string(line) {
+			switch {
+			case r == '"':
+				quoted = !quoted
+			case r == d && !quoted:
+				n++
+			}
+		}
+		if n > 0 {
+			counts[n]++
+		}
+	}
+	for n, lines := range counts {
+		if lines > matching || lines == matching && n > count {
+			count, matching = n, lines
+		}
+	}
+	return count, matching
+}
@@ -0,0 +1,154 @@
+package reader
+
+import (
+	"errors"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/meddhiazoghlami/goxls/pkg/models"
+)
+
+func writeCSVTestFile(t *testing.T, name, content string) string {
+	t.Helper()
+	path := filepath.Join(t.TempDir(), name)
+	if err := os.WriteFile(path, []byte(content), 0644); err != nil {
+		t.Fatalf("WriteFile() error = %v", err)
+	}
+	return path
+}
+
+func TestReadCSV_TypedCells(t *testing.T) {
+	path := writeCSVTestFile(t, "people.csv", "Name,Age,Active,Joined,Note\n"+
+		"Alice,30,true,2023-01-15,\"likes, commas\"\n"+
+		"Bob,25.5,false,2022-06-01,\n"+
+		"\n"+
+		"Charlie,,TRUE,not a date\n")
+
+	table, err := ReadCSV(path, CSVReadOptions{})
+	if err != nil {
+		t.Fatalf("ReadCSV() error = %v", err)
+	}
+
+	if table.Name != "people" {
+		t.Errorf("Name = %q, want %q", table.Name, "people")
+	}
+	if got := strings.Join(table.Headers, ","); got != "Name,Age,Active,Joined,Note" {
+		t.Errorf("Headers = %s, want Name,Age,Active,Joined,Note", got)
+	}
+	if len(table.Rows) != 3 {
+		t.Fatalf("Rows = %d, want 3", len(table.Rows))
+	}
+
+	tests := []struct {
+		row    int
+		column string
+		typ    models.CellType
+		value  interface{}
+	}{
+		{0, "Name", models.CellTypeString, "Alice"},
+		{0, "Age", models.CellTypeNumber, 30.0},
+		{0, "Active", models.CellTypeBool, true},
+		{0, "Joined", models.CellTypeDate, time.Date(2023, 1, 15, 0, 0, 0, 0, time.UTC)},
+		{0, "Note", models.CellTypeString, "likes, commas"},
+		{1, "Age", models.CellTypeNumber, 25.5},
+		{1, "Active", models.CellTypeBool, false},
+		{1, "Note", models.CellTypeEmpty, nil},
+		{2, "Age", models.CellTypeEmpty, nil},
+		{2, "Active", models.CellTypeBool, true},
+		{2, "Joined", models.CellTypeString, "not a date"},
+		{2, "Note", models.CellTypeEmpty, nil},
+	}
+	for _, tt := range tests {
+		cell := table.Rows[tt.row].Values[tt.column]
+		if cell.Type != tt.typ || cell.Value != tt.value {
+			t.Errorf("row %d %s = %v (%v), want %v (%v)", tt.row, tt.column, cell.Value, cell.Type, tt.value, tt.typ)
+		}
+	}
+}
+
+func TestReadCSV_Options(t *testing.T) {
+	path := writeCSVTestFile(t, "data.txt", "# exported data\n 1 ; x \n2;y\n")
+
+	table, err := ReadCSV(path, CSVReadOptions{Delimiter: ';', Comment: '#', NoHeader: true, TrimSpace: true, Name: "Data"})
+	if err != nil {
+		t.Fatalf("ReadCSV() error = %v", err)
+	}
+	if table.Name != "Data" || strings.Join(table.Headers, ",") != "Column_1,Column_2" {
+		t.Errorf("table = %q %v, want Data [Column_1 Column_2]", table.Name, table.Headers)
+	}
+	if got := table.ToRecords(false, ""); len(got) != 2 || got[0][0] != "1" || got[0][1] != "x" {
+		t.Errorf("records = %v, want [[1 x] [2 y]]", got)
+	}
+}
+
+func TestReadTSV(t *testing.T) {
+	path := writeCSVTestFile(t, "scores.tsv", "Name\tScore\nAlice\t90\nBob\t80\n")
+
+	table, err := ReadTSV(path, CSVReadOptions{})
+	if err != nil {
+		t.Fatalf("ReadTSV() error = %v", err)
+	}
+	if len(table.Headers) != 2 || len(table.Rows) != 2 {
+		t.Fatalf("table = %v with %d rows, want 2 columns and 2 rows", table.Headers, len(table.Rows))
+	}
+	score := table.Rows[1].Values["Score"]
+	if v, ok := score.AsFloat(); !ok || v != 80 {
+		t.Errorf("Score = %v, want 80", score.Value)
+	}
+}
+
+func TestReadCSV_MissingFile(t *testing.T) {
+	if _, err := ReadCSV(filepath.Join(t.TempDir(), "missing.csv"), CSVReadOptions{}); err == nil {
+		t.Error("ReadCSV() error = nil, want an error")
+	}
+}
+
+func TestSniffDelimiter(t *testing.T) {
+	tests := []struct {
+		name   string
+		sample string
+		want   rune
+	}{
+		{"comma", "Name,Age,City\nAlice,30,Paris\nBob,25,Rome\n", ','},
+		{"semicolon", "Name;Amount;Note\nAlice;1,50;a, b\nBob;2,75;c\n", ';'},
+		{"tab", "Name\tAge\nAlice\t30\nBob\t25\n", '\t'},
+		{"pipe", "id|name\n1|x\n2|y\n", '|'},
+		{"quoted commas", "Name;Note\nAlice;\"x, y, z\"\nBob;\"a, b\"\n", ';'},
+		{"truncated last line", "a,b,c\n1,2,3\n4;5", ','},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := SniffDelimiter([]byte(tt.sample))
+			if err != nil {
+				t.Fatalf("SniffDelimiter() error = %v", err)
+			}
+			if got != tt.want {
+				t.Errorf("SniffDelimiter() = %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestSniffDelimiter_Errors(t *testing.T) {
+	for _, sample := range []string{"a,b;c\nd,e;f\n", "just one column\nof text\n", ""} {
+		if got, err := SniffDelimiter([]byte(sample)); !errors.Is(err, ErrDelimiterNotDetected) {
+			t.Errorf("SniffDelimiter(%q) = %q, %v, want ErrDelimiterNotDetected", sample, got, err)
+		}
+	}
+}
+
+func TestReadCSV_SniffsDelimiter(t *testing.T) {
+	path := writeCSVTestFile(t, "eu.csv", "Name;Score\nAlice;90\nBob;80\n")
+
+	table, err := ReadCSV(path, CSVReadOptions{})
+	if err != nil {
+		t.Fatalf("ReadCSV() error = %v", err)
+	}
+	if got := strings.Join(table.Headers, ","); got != "Name,Score" {
+		t.Errorf("Headers = %s, want Name,Score", got)
+	}
+}
@@ -30,6 +30,7 @@
 //	    MaxEmptyRows:      2,     // Max empty rows before table boundary
 //	    HeaderDensity:     0.6,   // Min density for header row
 //	    ExpandMergedCells: true,  // Copy merged cell values
+//	    MergeFill:         models.FillOriginOnly, // Keep values in the top-left cell only
 //	}
 //	wr := reader.NewWorkbookReaderWithConfig(config)
 //
@@ -47,6 +48,17 @@
 //	ranges, _ := nr.GetNamedRanges("data.xlsx")
 //	table, _ := nr.ReadRange("data.xlsx", "SalesData")
 //
+// # CSV and TSV Files
+//
+// Delimited text files read into the same Table type, with cell types
+// inferred from the text:
+//
+//	table, err := reader.ReadCSV("data.csv", reader.CSVReadOptions{})
+//	scores, err := reader.ReadTSV("scores.tsv", reader.CSVReadOptions{})
+//
+// Without a Delimiter, ReadCSV detects comma, semicolon, tab or pipe
+// separators with SniffDelimiter, which can also be called on a sample directly.
+//
 // # Components
 //
 // The reader package consists of several components:
@@ -178,13 +178,28 @@ func (hd *HeaderDetector) normalizeHeader(value string, colIndex int, usedNames
 		header = fmt.Sprintf("Column_%d", colIndex+1)
 	}
 
-	// Handle duplicates by appending a number
+	// Handle duplicates with the configured resolver, or by appending a number.
+	// A resolved name that is already taken falls back to the number too.
 	originalHeader := header
 	count, exists := usedNames[strings.ToLower(header)]
 	if exists {
-		header = fmt.Sprintf("%s_%d", originalHeader, count+1)
+		header = ""
+		if hd.config.DuplicateHeaderResolver != nil {
+			header = hd.config.DuplicateHeaderResolver(originalHeader, colIndex)
+		}
+		if _, taken := usedNames[strings.ToLower(header)]; header == "" || taken {
+			for n := count + 1; ; n++ {
+				header = fmt.Sprintf("%s_%d", originalHeader, n)
+				if _, taken := usedNames[strings.ToLower(header)]; !taken {
+					break
+				}
+			}
+		}
 	}
 	usedNames[strings.ToLower(originalHeader)] = count + 1
+	if header != originalHeader {
+		usedNames[strings.ToLower(header)]++
+	}
 
 	return header
 }
@@ -210,6 +225,12 @@ func (hd *HeaderDetector) ValidateHeaders(headers []string) bool {
 // DetectHeaderRows detects multi-row headers (common with merged cells)
 // Returns the start and end row indices for the header region
 func (hd *HeaderDetector) DetectHeaderRows(grid [][]models.Cell, boundary models.TableBoundary) (headerStart int, headerEnd int) {
+	return hd.detectHeaderRows(grid, boundary, false)
+}
+
+// detectHeaderRows is DetectHeaderRows; with groups set, a group header merged
+// across columns also takes in the sub-header row below it
+func (hd *HeaderDetector) detectHeaderRows(grid [][]models.Cell, boundary models.TableBoundary, groups bool) (headerStart int, headerEnd int) {
 	headerStart = boundary.StartRow
 	headerEnd = boundary.StartRow
 
@@ -224,6 +245,15 @@ func (hd *HeaderDetector) DetectHeaderRows(grid [][]models.Cell, boundary models
 			// Merged cell spans multiple rows - extend header range
 			headerEnd = cell.MergeRange.EndRow
 		}
+		if groups && cell.MergeRange != nil && cell.MergeRange.EndCol > cell.MergeRange.StartCol && headerEnd == headerStart {
+			// Group header spanning columns - sub-headers sit on the next row
+			headerEnd = headerStart + 1
+		}
+	}
+
+	// Without merges, a header may still be split into words over two rows
+	if hd.config.CoalesceHeaderWords && headerEnd == headerStart && hd.isStackedHeader(grid, headerStart, boundary) {
+		headerEnd = headerStart + 1
 	}
 
 	// Limit header rows to a reasonable maximum
@@ -240,9 +270,61 @@ func (hd *HeaderDetector) DetectHeaderRows(grid [][]models.Cell, boundary models
 	return headerStart, headerEnd
 }
 
+// isStackedHeader reports whether row and the row below it both hold header text,
+// e.g. "First" over "Name": both rows are text only, the top row is dense, and the
+// first data row has typed values where the second row has text
+func (hd *HeaderDetector) isStackedHeader(grid [][]models.Cell, row int, boundary models.TableBoundary) bool {
+	dataRow := row + 2
+	if dataRow > boundary.EndRow || dataRow >= len(grid) {
+		return false
+	}
+
+	width := boundary.EndCol - boundary.StartCol + 1
+	topCount, nextCount := 0, 0
+	typedData := false
+	for col := boundary.StartCol; col <= boundary.EndCol; col++ {
+		top, next, data := cellAt(grid, row, col), cellAt(grid, row+1, col), cellAt(grid, dataRow, col)
+		if top.MergeRange != nil || next.MergeRange != nil {
+			return false
+		}
+		if !top.IsEmpty() {
+			if top.Type != models.CellTypeString {
+				return false
+			}
+			topCount++
+		}
+		if !next.IsEmpty() {
+			if next.Type != models.CellTypeString {
+				return false
+			}
+			nextCount++
+		}
+		if !data.IsEmpty() && data.Type != models.CellTypeString {
+			typedData = true
+		}
+	}
+
+	return nextCount > 0 && typedData && float64(topCount)/float64(width) >= hd.config.HeaderDensity
+}
+
+// cellAt returns the cell at row, col or an empty cell when out of range
+func cellAt(grid [][]models.Cell, row, col int) models.Cell {
+	if row < 0 || row >= len(grid) || col < 0 || col >= len(grid[row]) {
+		return models.Cell{}
+	}
+	return grid[row][col]
+}
+
 // ExtractHierarchicalHeaders extracts multi-level header structure for merged headers
 // Returns a 2D slice where each inner slice represents one header level
 func (hd *HeaderDetector) ExtractHierarchicalHeaders(grid [][]models.Cell, headerStart, headerEnd int, boundary models.TableBoundary) [][]string {
+	return hd.extractHeaderLevels(grid, headerStart, headerEnd, boundary, false)
+}
+
+// extractHeaderLevels is ExtractHierarchicalHeaders; with raw set, names are
+// only trimmed, so a merged group header repeats across its columns and
+// uniqueness is left to the caller after flattening
+func (hd *HeaderDetector) extractHeaderLevels(grid [][]models.Cell, headerStart, headerEnd int, boundary models.TableBoundary, raw bool) [][]string {
 	levels := headerEnd - headerStart + 1
 	if levels <= 0 {
 		return nil
@@ -261,7 +343,14 @@ func (hd *HeaderDetector) ExtractHierarchicalHeaders(grid [][]models.Cell, heade
 
 		for col := boundary.StartCol; col <= boundary.EndCol && col < len(grid[row]); col++ {
 			cell := grid[row][col]
-			header := hd.normalizeHeader(cell.AsString(), col, usedNames)
+			if !raw {
+				result[level] = append(result[level], hd.normalizeHeader(cell.AsString(), col, usedNames))
+				continue
+			}
+			header := strings.TrimSpace(cell.AsString())
+			if header == "" {
+				header = fmt.Sprintf("Column_%d", col+1)
+			}
 			result[level] = append(result[level], header)
 		}
 	}
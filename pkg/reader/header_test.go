@@ -5,6 +5,7 @@ import (
 	"testing"
 
 	"github.com/meddhiazoghlami/goxls/pkg/models"
+	"github.com/xuri/excelize/v2"
 )
 
 // =============================================================================
@@ -262,6 +263,54 @@ func TestHeaderDetector_ExtractHeaders_Duplicates(t *testing.T) {
 	}
 }
 
+func TestHeaderDetector_ExtractHeaders_DuplicateResolver(t *testing.T) {
+	config := models.DefaultConfig()
+	config.DuplicateHeaderResolver = func(name string, colIndex int) string {
+		letter, _ := excelize.ColumnNumberToName(colIndex + 1)
+		return name + "_" + letter
+	}
+	hd := NewHeaderDetector(config)
+
+	grid := [][]models.Cell{
+		{
+			makeCell("Name", models.CellTypeString),
+			makeCell("Name", models.CellTypeString),
+			makeCell("name", models.CellTypeString),
+			makeCell("Age", models.CellTypeString),
+		},
+	}
+	boundary := models.TableBoundary{StartRow: 0, EndRow: 0, StartCol: 0, EndCol: 3}
+
+	headers := hd.ExtractHeaders(grid, 0, boundary)
+	if got := strings.Join(headers, ","); got != "Name,Name_B,name_C,Age" {
+		t.Errorf("headers = %q, want %q", got, "Name,Name_B,name_C,Age")
+	}
+
+	// An empty result falls back to the numeric suffix
+	config.DuplicateHeaderResolver = func(string, int) string { return "" }
+	headers = NewHeaderDetector(config).ExtractHeaders(grid, 0, boundary)
+	if got := strings.Join(headers, ","); got != "Name,Name_2,name_3,Age" {
+		t.Errorf("headers = %q, want %q", got, "Name,Name_2,name_3,Age")
+	}
+
+	// Names the resolver returns that are already taken fall back to the suffix
+	config.DuplicateHeaderResolver = func(string, int) string { return "AGE" }
+	grid = [][]models.Cell{
+		{
+			makeCell("Age", models.CellTypeString),
+			makeCell("Name", models.CellTypeString),
+			makeCell("Name", models.CellTypeString),
+			makeCell("Name", models.CellTypeString),
+			makeCell("Name_2", models.CellTypeString),
+		},
+	}
+	boundary.EndCol = 4
+	headers = NewHeaderDetector(config).ExtractHeaders(grid, 0, boundary)
+	if got := strings.Join(headers, ","); got != "Age,Name,Name_2,Name_3,Name_2_2" {
+		t.Errorf("headers = %q, want %q", got, "Age,Name,Name_2,Name_3,Name_2_2")
+	}
+}
+
 func TestHeaderDetector_ExtractHeaders_Whitespace(t *testing.T) {
 	hd := NewDefaultHeaderDetector()
 
@@ -765,6 +814,76 @@ func TestHeaderDetector_DetectHeaderRows_WithMergedMultiRow(t *testing.T) {
 	}
 }
 
+func TestHeaderDetector_detectHeaderRows_WithMergedGroup(t *testing.T) {
+	hd := NewDefaultHeaderDetector()
+
+	// Group header merged across columns with sub-headers below
+	group := &models.MergeRange{StartRow: 0, StartCol: 0, EndRow: 0, EndCol: 1}
+	grid := [][]models.Cell{
+		{
+			{Value: "Group", Type: models.CellTypeString, RawValue: "Group", IsMerged: true, MergeRange: &models.MergeRange{StartRow: 0, StartCol: 0, EndRow: 0, EndCol: 1, IsOrigin: true}},
+			{Value: "Group", Type: models.CellTypeString, RawValue: "Group", IsMerged: true, MergeRange: group},
+		},
+		{makeCell("Sub1", models.CellTypeString), makeCell("Sub2", models.CellTypeString)},
+		{makeCell("Data1", models.CellTypeString), makeCell("Data2", models.CellTypeString)},
+	}
+
+	boundary := models.TableBoundary{StartRow: 0, EndRow: 2, StartCol: 0, EndCol: 1}
+
+	start, end := hd.detectHeaderRows(grid, boundary, true)
+	if start != 0 || end != 1 {
+		t.Errorf("detectHeaderRows() = (%d, %d), want (0, 1)", start, end)
+	}
+
+	// The public method keeps a single-row merge to one header row
+	start, end = hd.DetectHeaderRows(grid, boundary)
+	if start != 0 || end != 0 {
+		t.Errorf("DetectHeaderRows() = (%d, %d), want (0, 0)", start, end)
+	}
+}
+
+func TestHeaderDetector_extractHeaderLevels_KeepsRepeatedNames(t *testing.T) {
+	hd := NewDefaultHeaderDetector()
+
+	grid := [][]models.Cell{
+		{makeCell("Group A", models.CellTypeString), makeCell("Group A", models.CellTypeString)},
+		{makeCell("Sub1", models.CellTypeString), makeCell("Sub2", models.CellTypeString)},
+	}
+
+	boundary := models.TableBoundary{StartRow: 0, EndRow: 1, StartCol: 0, EndCol: 1}
+
+	result := hd.extractHeaderLevels(grid, 0, 1, boundary, true)
+	if result[0][1] != "Group A" {
+		t.Errorf("result[0][1] = %q, want %q", result[0][1], "Group A")
+	}
+
+	// The public method still makes names unique within a level
+	result = hd.ExtractHierarchicalHeaders(grid, 0, 1, boundary)
+	if result[0][1] != "Group A_2" {
+		t.Errorf("ExtractHierarchicalHeaders()[0][1] = %q, want %q", result[0][1], "Group A_2")
+	}
+}
+
+func TestHeaderDetector_DetectHeaderRows_StackedWords(t *testing.T) {
+	grid := [][]models.Cell{
+		{makeCell("ID", models.CellTypeString), makeCell("First", models.CellTypeString), makeCell("Last", models.CellTypeString)},
+		{makeEmptyCell(), makeCell("Name", models.CellTypeString), makeCell("Name", models.CellTypeString)},
+		{makeCell("1", models.CellTypeNumber), makeCell("Alice", models.CellTypeString), makeCell("Smith", models.CellTypeString)},
+	}
+	boundary := models.TableBoundary{StartRow: 0, EndRow: 2, StartCol: 0, EndCol: 2}
+
+	// Stacked words need the option; without merges the header is one row
+	if start, end := NewDefaultHeaderDetector().DetectHeaderRows(grid, boundary); start != 0 || end != 0 {
+		t.Errorf("DetectHeaderRows() without coalescing = (%d, %d), want (0, 0)", start, end)
+	}
+
+	config := models.DefaultConfig()
+	config.CoalesceHeaderWords = true
+	if start, end := NewHeaderDetector(config).DetectHeaderRows(grid, boundary); start != 0 || end != 1 {
+		t.Errorf("DetectHeaderRows() = (%d, %d), want (0, 1)", start, end)
+	}
+}
+
 func TestHeaderDetector_DetectHeaderRows_EmptyGrid(t *testing.T) {
 	hd := NewDefaultHeaderDetector()
 
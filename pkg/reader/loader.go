@@ -4,15 +4,20 @@ import (
 	"errors"
 	"os"
 	"path/filepath"
+	"strings"
+	"time"
 
+	"github.com/meddhiazoghlami/goxls/pkg/models"
 	"github.com/xuri/excelize/v2"
 )
 
 var (
 	ErrFileNotFound    = errors.New("file not found")
-	ErrInvalidFormat   = errors.New("invalid file format: only .xlsx files are supported")
+	ErrInvalidFormat   = errors.New("invalid file format: only .xlsx and .xlsm files are supported")
 	ErrFileEmpty       = errors.New("file is empty")
 	ErrCannotOpenFile  = errors.New("cannot open file")
+	ErrLegacyXLSFormat = errors.New("legacy .xls workbooks are not supported: save the file as .xlsx")
+	ErrWrongPassword   = errors.New("cannot open workbook: the password is incorrect")
 )
 
 // ExcelFile wraps an excelize file with additional functionality
@@ -23,6 +28,13 @@ type ExcelFile struct {
 
 // LoadFile opens and validates an Excel file
 func LoadFile(path string) (*ExcelFile, error) {
+	return LoadFileWithPassword(path, "")
+}
+
+// LoadFileWithPassword opens and validates an Excel file, decrypting it with
+// password when it is protected. A password that does not open the file
+// returns ErrWrongPassword.
+func LoadFileWithPassword(path, password string) (*ExcelFile, error) {
 	// Check if file exists
 	info, err := os.Stat(path)
 	if os.IsNotExist(err) {
@@ -37,15 +49,23 @@ func LoadFile(path string) (*ExcelFile, error) {
 		return nil, ErrFileEmpty
 	}
 
-	// Check file extension
-	ext := filepath.Ext(path)
-	if ext != ".xlsx" {
+	// Check file extension; macro-enabled workbooks are read like .xlsx and their macros ignored
+	switch strings.ToLower(filepath.Ext(path)) {
+	case ".xlsx", ".xlsm":
+	case ".xls":
+		return nil, ErrLegacyXLSFormat
+	default:
 		return nil, ErrInvalidFormat
 	}
 
 	// Open the file
-	f, err := excelize.OpenFile(path)
+	f, err := excelize.OpenFile(path, excelize.Options{Password: password})
 	if err != nil {
+		// A failed decryption is reported as an unsupported format
+		if errors.Is(err, excelize.ErrWorkbookPassword) ||
+			(password != "" && errors.Is(err, excelize.ErrWorkbookFileFormat)) {
+			return nil, ErrWrongPassword
+		}
 		return nil, errors.Join(ErrCannotOpenFile, err)
 	}
 
@@ -78,16 +98,131 @@ func (ef *ExcelFile) GetRows(sheetName string) ([][]string, error) {
 	return ef.file.GetRows(sheetName)
 }
 
+// ForEachRow calls fn with the values of each row in a sheet as it is read.
+// Rows without values are passed as empty slices. As with GetRows, reading
+// stops quietly at a row that cannot be parsed.
+func (ef *ExcelFile) ForEachRow(sheetName string, fn func(row []string) error) error {
+	rows, err := ef.file.Rows(sheetName)
+	if err != nil {
+		return err
+	}
+	for rows.Next() {
+		row, err := rows.Columns()
+		if err != nil {
+			break
+		}
+		if err := fn(row); err != nil {
+			rows.Close()
+			return err
+		}
+	}
+	return rows.Close()
+}
+
 // GetCellValue returns the value of a specific cell
 func (ef *ExcelFile) GetCellValue(sheetName string, cell string) (string, error) {
 	return ef.file.GetCellValue(sheetName, cell)
 }
 
+// GetCellRawValue returns the stored value of a cell without number formatting applied
+func (ef *ExcelFile) GetCellRawValue(sheetName string, cell string) (string, error) {
+	return ef.file.GetCellValue(sheetName, cell, excelize.Options{RawCellValue: true})
+}
+
+// GetCellStyle returns the style index of a specific cell
+func (ef *ExcelFile) GetCellStyle(sheetName string, cell string) (int, error) {
+	return ef.file.GetCellStyle(sheetName, cell)
+}
+
+// QuotePrefixStyles returns the IDs of cell styles with quotePrefix set, the
+// text marker Excel stores for values entered with a leading apostrophe
+func (ef *ExcelFile) QuotePrefixStyles() map[int]bool {
+	// GetStyle loads the style sheet, which is read lazily
+	if _, err := ef.file.GetStyle(0); err != nil || ef.file.Styles == nil || ef.file.Styles.CellXfs == nil {
+		return nil
+	}
+	styles := make(map[int]bool)
+	for id, xf := range ef.file.Styles.CellXfs.Xf {
+		if xf.QuotePrefix != nil && *xf.QuotePrefix {
+			styles[id] = true
+		}
+	}
+	return styles
+}
+
+// builtinNumberFormats maps the built-in numeric format IDs to their format codes.
+// Built-in date and time formats are left to date detection.
+var builtinNumberFormats = map[int]string{
+	1:  "0",
+	2:  "0.00",
+	3:  "#,##0",
+	4:  "#,##0.00",
+	5:  `"$"#,##0_);\("$"#,##0\)`,
+	6:  `"$"#,##0_);[Red]\("$"#,##0\)`,
+	7:  `"$"#,##0.00_);\("$"#,##0.00\)`,
+	8:  `"$"#,##0.00_);[Red]\("$"#,##0.00\)`,
+	9:  "0%",
+	10: "0.00%",
+	11: "0.00E+00",
+	37: "#,##0_);(#,##0)",
+	38: "#,##0_);[Red](#,##0)",
+	39: "#,##0.00_);(#,##0.00)",
+	40: "#,##0.00_);[Red](#,##0.00)",
+	42: `_("$"* #,##0_);_("$"* \(#,##0\);_("$"* "-"_);_(@_)`,
+	44: `_("$"* #,##0.00_);_("$"* \(#,##0.00\);_("$"* "-"??_);_(@_)`,
+	48: "##0.0E+0",
+}
+
+// GetNumberFormat returns the number format code of a style, or "" for General
+func (ef *ExcelFile) GetNumberFormat(styleID int) (string, error) {
+	if styleID == 0 {
+		return "", nil
+	}
+	style, err := ef.file.GetStyle(styleID)
+	if err != nil {
+		return "", err
+	}
+	if style.CustomNumFmt != nil {
+		return *style.CustomNumFmt, nil
+	}
+	return builtinNumberFormats[style.NumFmt], nil
+}
+
 // GetCellType returns the type of a specific cell
 func (ef *ExcelFile) GetCellType(sheetName string, cell string) (excelize.CellType, error) {
 	return ef.file.GetCellType(sheetName, cell)
 }
 
+// GetRowVisible reports whether a row is visible (row is 1-indexed)
+func (ef *ExcelFile) GetRowVisible(sheetName string, row int) (bool, error) {
+	return ef.file.GetRowVisible(sheetName, row)
+}
+
+// GetColVisible reports whether a column is visible (col is a column name like "B")
+func (ef *ExcelFile) GetColVisible(sheetName string, col string) (bool, error) {
+	return ef.file.GetColVisible(sheetName, col)
+}
+
+// GetSheetVisibility reports whether a sheet is visible, hidden or very hidden
+func (ef *ExcelFile) GetSheetVisibility(sheetName string) (models.SheetVisibility, error) {
+	visible, err := ef.file.GetSheetVisible(sheetName)
+	if err != nil {
+		return models.VisibilityVisible, err
+	}
+	if visible {
+		return models.VisibilityVisible, nil
+	}
+	// excelize only reports visible or not; the workbook is loaded by the call above
+	if ef.file.WorkBook != nil {
+		for _, sheet := range ef.file.WorkBook.Sheets.Sheet {
+			if strings.EqualFold(sheet.Name, sheetName) && sheet.State == "veryHidden" {
+				return models.VisibilityVeryHidden, nil
+			}
+		}
+	}
+	return models.VisibilityHidden, nil
+}
+
 // FilePath returns the path of the loaded file
 func (ef *ExcelFile) FilePath() string {
 	return ef.filePath
@@ -165,6 +300,82 @@ func (ef *ExcelFile) GetCellHyperLink(sheetName string, cell string) (string, er
 	return link, nil
 }
 
+// filterDatabaseName is the built-in defined name Excel uses for a sheet's AutoFilter range
+const filterDatabaseName = "_xlnm._FilterDatabase"
+
+// GetExcelTables returns the Excel Tables (ListObjects) on a sheet followed by
+// its AutoFilter range, if any
+func (ef *ExcelFile) GetExcelTables(sheetName string) ([]models.ExcelTableRef, error) {
+	tables, err := ef.file.GetTables(sheetName)
+	if err != nil {
+		return nil, err
+	}
+
+	refs := make([]models.ExcelTableRef, 0, len(tables)+1)
+	for _, t := range tables {
+		refs = append(refs, models.ExcelTableRef{Name: t.Name, Range: strings.ReplaceAll(t.Range, "$", "")})
+	}
+
+	for _, dn := range ef.file.GetDefinedName() {
+		if dn.Name != filterDatabaseName || dn.Scope != sheetName {
+			continue
+		}
+		cellRange := dn.RefersTo
+		if i := strings.LastIndex(cellRange, "!"); i >= 0 {
+			cellRange = cellRange[i+1:]
+		}
+		refs = append(refs, models.ExcelTableRef{Range: strings.ReplaceAll(cellRange, "$", ""), AutoFilter: true})
+	}
+	return refs, nil
+}
+
+// GetFrozenPanes returns the number of rows and columns frozen on a sheet
+func (ef *ExcelFile) GetFrozenPanes(sheetName string) (rows, cols int, err error) {
+	panes, err := ef.file.GetPanes(sheetName)
+	if err != nil || !panes.Freeze {
+		return 0, 0, err
+	}
+	return panes.YSplit, panes.XSplit, nil
+}
+
+// printAreaName is the built-in defined name Excel uses for a sheet's print area
+const printAreaName = "_xlnm.Print_Area"
+
+// GetPrintArea returns a sheet's print area range, or an empty string if none is set
+func (ef *ExcelFile) GetPrintArea(sheetName string) string {
+	for _, dn := range ef.file.GetDefinedName() {
+		if dn.Name != printAreaName || dn.Scope != sheetName {
+			continue
+		}
+		cellRange := dn.RefersTo
+		if i := strings.LastIndex(cellRange, "!"); i >= 0 {
+			cellRange = cellRange[i+1:]
+		}
+		return strings.ReplaceAll(cellRange, "$", "")
+	}
+	return ""
+}
+
+// GetProperties returns the workbook's document properties. Properties that
+// are missing or unreadable are left empty.
+func (ef *ExcelFile) GetProperties() models.DocumentProperties {
+	var props models.DocumentProperties
+	if doc, err := ef.file.GetDocProps(); err == nil && doc != nil {
+		props.Creator = doc.Creator
+		props.LastModifiedBy = doc.LastModifiedBy
+		props.Title = doc.Title
+		props.Created, _ = time.Parse(time.RFC3339, doc.Created)
+		props.Modified, _ = time.Parse(time.RFC3339, doc.Modified)
+	}
+	if app, err := ef.file.GetAppProps(); err == nil && app != nil {
+		props.Company = app.Company
+	}
+	if calc, err := ef.file.GetCalcProps(); err == nil && calc.CalcMode != nil {
+		props.CalcMode = *calc.CalcMode
+	}
+	return props
+}
+
 // DefinedNameInfo represents an Excel named range
 type DefinedNameInfo struct {
 	Name     string // The name of the range
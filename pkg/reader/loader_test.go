@@ -79,10 +79,8 @@ func TestLoadFile_InvalidExtension(t *testing.T) {
 		filename string
 	}{
 		{"csv file", "test.csv"},
-		{"xls file", "test.xls"},
 		{"txt file", "test.txt"},
 		{"no extension", "testfile"},
-		{"xlsm file", "test.xlsm"},
 		{"json file", "test.json"},
 	}
 
@@ -109,6 +107,68 @@ func TestLoadFile_InvalidExtension(t *testing.T) {
 	}
 }
 
+func TestLoadFile_LegacyXLS(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "legacy.xls")
+	// OLE2 compound document signature used by binary .xls files
+	ole := []byte{0xD0, 0xCF, 0x11, 0xE0, 0xA1, 0xB1, 0x1A, 0xE1}
+	if err := os.WriteFile(path, ole, 0644); err != nil {
+		t.Fatalf("Failed to create test file: %v", err)
+	}
+
+	_, err := LoadFile(path)
+
+	if !errors.Is(err, ErrLegacyXLSFormat) {
+		t.Errorf("LoadFile() error = %v, want ErrLegacyXLSFormat", err)
+	}
+}
+
+func TestLoadFile_XLSM(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "macros.xlsm")
+	f := excelize.NewFile()
+	f.SetCellValue("Sheet1", "A1", "Name")
+	f.SetCellValue("Sheet1", "A2", "Alice")
+	if err := f.SaveAs(path); err != nil {
+		t.Fatalf("Failed to create test file: %v", err)
+	}
+	f.Close()
+
+	ef, err := LoadFile(path)
+	if err != nil {
+		t.Fatalf("LoadFile() error = %v", err)
+	}
+	defer ef.Close()
+
+	if v, _ := ef.GetCellValue("Sheet1", "A2"); v != "Alice" {
+		t.Errorf("GetCellValue(A2) = %q, want %q", v, "Alice")
+	}
+}
+
+func TestLoadFileWithPassword(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "protected.xlsx")
+	f := excelize.NewFile()
+	f.SetCellValue("Sheet1", "A1", "Secret")
+	if err := f.SaveAs(path, excelize.Options{Password: "letmein"}); err != nil {
+		t.Fatalf("Failed to create test file: %v", err)
+	}
+	f.Close()
+
+	ef, err := LoadFileWithPassword(path, "letmein")
+	if err != nil {
+		t.Fatalf("LoadFileWithPassword() error = %v", err)
+	}
+	defer ef.Close()
+	if v, _ := ef.GetCellValue("Sheet1", "A1"); v != "Secret" {
+		t.Errorf("GetCellValue(A1) = %q, want %q", v, "Secret")
+	}
+
+	if _, err := LoadFileWithPassword(path, "wrong"); !errors.Is(err, ErrWrongPassword) {
+		t.Errorf("LoadFileWithPassword(wrong) error = %v, want ErrWrongPassword", err)
+	}
+	if _, err := LoadFile(path); !errors.Is(err, ErrCannotOpenFile) {
+		t.Errorf("LoadFile() without password error = %v, want ErrCannotOpenFile", err)
+	}
+}
+
 func TestLoadFile_EmptyFile(t *testing.T) {
 	path := createEmptyFile(t, "empty.xlsx")
 
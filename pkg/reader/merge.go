@@ -93,11 +93,23 @@ func (mp *MergeProcessor) applyMerge(grid [][]models.Cell, merge ParsedMergeRang
 			cell := &grid[row][col]
 
 			if mp.config.ExpandMergedCells {
-				// Copy value from origin to all cells in the merge range
-				cell.RawValue = merge.Value
-				cell.Value = originValue
-				if !isOrigin {
-					cell.Type = originCellType
+				switch mp.config.MergeFill {
+				case models.FillAll:
+					// Copy value from origin to all cells in the merge range
+					cell.RawValue = merge.Value
+					cell.Value = originValue
+					if !isOrigin {
+						cell.Type = originCellType
+					}
+				case models.FillOriginOnly:
+					if isOrigin {
+						cell.RawValue = merge.Value
+						cell.Value = originValue
+					} else {
+						cell.RawValue = ""
+						cell.Value = nil
+						cell.Type = models.CellTypeEmpty
+					}
 				}
 			}
 
@@ -181,6 +181,45 @@ func TestMergeProcessor_ApplyMerges_Basic(t *testing.T) {
 	}
 }
 
+func TestMergeProcessor_ApplyMerges_MergeFill(t *testing.T) {
+	tests := []struct {
+		name      string
+		fill      models.MergeFill
+		wantOther string // RawValue expected in the non-origin cell
+	}{
+		{"fill all", models.FillAll, "Merged"},
+		{"origin only", models.FillOriginOnly, ""},
+		{"none", models.FillNone, "stale"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			config := models.DefaultConfig()
+			config.MergeFill = tt.fill
+			grid := [][]models.Cell{{
+				{Value: "Merged", RawValue: "Merged", Type: models.CellTypeString},
+				{Value: "stale", RawValue: "stale", Type: models.CellTypeString, Col: 1},
+			}}
+
+			NewMergeProcessor(config).ApplyMerges(grid, []ParsedMergeRange{
+				{StartRow: 0, StartCol: 0, EndRow: 0, EndCol: 1, Value: "Merged"},
+			})
+
+			if grid[0][0].RawValue != "Merged" {
+				t.Errorf("origin RawValue = %q, want Merged", grid[0][0].RawValue)
+			}
+			if grid[0][1].RawValue != tt.wantOther {
+				t.Errorf("non-origin RawValue = %q, want %q", grid[0][1].RawValue, tt.wantOther)
+			}
+			for col := range grid[0] {
+				if !grid[0][col].IsMerged || grid[0][col].MergeRange == nil {
+					t.Errorf("Cell[0][%d] merge metadata missing", col)
+				}
+			}
+		})
+	}
+}
+
 func TestMergeProcessor_ApplyMerges_EmptyValue(t *testing.T) {
 	config := models.DefaultConfig()
 	mp := NewMergeProcessor(config)
@@ -1,6 +1,8 @@
 package reader
 
 import (
+	"strings"
+
 	"github.com/meddhiazoghlami/goxls/pkg/models"
 )
 
@@ -26,9 +28,19 @@ func (rp *RowParser) ParseRows(grid [][]models.Cell, headers []string, headerRow
 	// Start from the row after the header
 	for rowIdx := headerRow + 1; rowIdx <= boundary.EndRow && rowIdx < len(grid); rowIdx++ {
 		row := rp.parseRow(grid, rowIdx, headers, boundary)
-		if row != nil && !rp.isEmptyRow(row) {
-			rows = append(rows, *row)
+		if row == nil || rp.isEmptyRow(row) {
+			continue
+		}
+		if rp.config.RemoveRepeatedHeaders && rp.isHeaderRepeat(grid, row, headerRow, boundary) {
+			continue
+		}
+		if rp.config.MergeContinuationRows && len(rows) > 0 {
+			if col, ok := rp.continuationColumn(row); ok {
+				rp.appendContinuation(&rows[len(rows)-1], row, headers[col], col)
+				continue
+			}
 		}
+		rows = append(rows, *row)
 	}
 
 	return rows
@@ -68,6 +80,42 @@ func (rp *RowParser) parseRow(grid [][]models.Cell, rowIdx int, headers []string
 	return row
 }
 
+// continuationColumn reports whether a row holds wrapped text for the row above:
+// exactly one cell is populated and it is not in the first (key) column
+func (rp *RowParser) continuationColumn(row *models.Row) (int, bool) {
+	col := -1
+	for i, cell := range row.Cells {
+		if cell.IsEmpty() {
+			continue
+		}
+		if col != -1 {
+			return 0, false
+		}
+		col = i
+	}
+	return col, col > 0
+}
+
+// appendContinuation appends a continuation row's text to the previous row
+func (rp *RowParser) appendContinuation(prev *models.Row, row *models.Row, header string, col int) {
+	cell := prev.Values[header]
+	text := row.Cells[col].RawValue
+	if !cell.IsEmpty() {
+		text = cell.RawValue + "\n" + text
+	} else {
+		cell.Row, cell.Col = row.Cells[col].Row, row.Cells[col].Col
+	}
+
+	cell.Value = text
+	cell.RawValue = text
+	cell.Type = models.CellTypeString
+
+	prev.Values[header] = cell
+	if col < len(prev.Cells) {
+		prev.Cells[col] = cell
+	}
+}
+
 // isEmptyRow checks if all cells in the row are empty
 func (rp *RowParser) isEmptyRow(row *models.Row) bool {
 	if row == nil || len(row.Cells) == 0 {
@@ -81,20 +129,47 @@ func (rp *RowParser) isEmptyRow(row *models.Row) bool {
 	return true
 }
 
+// isHeaderRepeat reports whether a row holds the same text as the header row
+// in every column
+func (rp *RowParser) isHeaderRepeat(grid [][]models.Cell, row *models.Row, headerRow int, boundary models.TableBoundary) bool {
+	for i, cell := range row.Cells {
+		header := cellAt(grid, headerRow, boundary.StartCol+i)
+		if strings.TrimSpace(cell.AsString()) != strings.TrimSpace(header.AsString()) {
+			return false
+		}
+	}
+	return true
+}
+
 // ParseTable combines all components to parse a complete table
 func (rp *RowParser) ParseTable(grid [][]models.Cell, boundary models.TableBoundary, headers []string, headerRow int, tableName string) models.Table {
 	rows := rp.ParseRows(grid, headers, headerRow, boundary)
 
 	return models.Table{
-		Name:      tableName,
-		Headers:   headers,
-		Rows:      rows,
-		StartRow:  boundary.StartRow,
-		EndRow:    boundary.EndRow,
-		StartCol:  boundary.StartCol,
-		EndCol:    boundary.EndCol,
-		HeaderRow: headerRow,
+		Name:        tableName,
+		Headers:     headers,
+		HeaderCells: rp.headerCells(grid, headers, headerRow, boundary),
+		Rows:        rows,
+		StartRow:    boundary.StartRow,
+		EndRow:      boundary.EndRow,
+		StartCol:    boundary.StartCol,
+		EndCol:      boundary.EndCol,
+		HeaderRow:   headerRow,
+	}
+}
+
+// headerCells returns the source cell of each header, in header order
+func (rp *RowParser) headerCells(grid [][]models.Cell, headers []string, headerRow int, boundary models.TableBoundary) []models.Cell {
+	cells := make([]models.Cell, len(headers))
+	for i := range headers {
+		colIdx := boundary.StartCol + i
+		if headerRow < len(grid) && colIdx < len(grid[headerRow]) {
+			cells[i] = grid[headerRow][colIdx]
+		} else {
+			cells[i] = models.Cell{Type: models.CellTypeEmpty, Row: headerRow, Col: colIdx}
+		}
 	}
+	return cells
 }
 
 // FilterRows filters rows based on a predicate function
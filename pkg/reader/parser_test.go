@@ -124,6 +124,43 @@ func TestRowParser_ParseRows_SkipsEmptyRows(t *testing.T) {
 	}
 }
 
+func TestRowParser_ParseRows_MergeContinuationRows(t *testing.T) {
+	grid := [][]models.Cell{
+		{makeCell("ID", models.CellTypeString), makeCell("Description", models.CellTypeString), makeCell("Qty", models.CellTypeString)},
+		{makeCell("1", models.CellTypeNumber), makeCell("line1", models.CellTypeString), makeCell("5", models.CellTypeNumber)},
+		{makeEmptyCell(), makeCell("line2", models.CellTypeString), makeEmptyCell()}, // Wrapped text
+		{makeCell("2", models.CellTypeNumber), makeCell("other", models.CellTypeString), makeCell("7", models.CellTypeNumber)},
+	}
+
+	headers := []string{"ID", "Description", "Qty"}
+	boundary := models.TableBoundary{StartRow: 0, EndRow: 3, StartCol: 0, EndCol: 2}
+
+	config := models.DefaultConfig()
+	config.MergeContinuationRows = true
+	rows := NewRowParser(config).ParseRows(grid, headers, 0, boundary)
+
+	if len(rows) != 2 {
+		t.Fatalf("ParseRows() returned %d rows, want 2", len(rows))
+	}
+	desc, _ := rows[0].Get("Description")
+	if desc.AsString() != "line1\nline2" {
+		t.Errorf("Description = %q, want %q", desc.AsString(), "line1\nline2")
+	}
+	if rows[0].Cells[1].AsString() != "line1\nline2" {
+		t.Errorf("Cells[1] = %q, want %q", rows[0].Cells[1].AsString(), "line1\nline2")
+	}
+	id, _ := rows[1].Get("ID")
+	if id.AsString() != "2" {
+		t.Errorf("rows[1] ID = %q, want %q", id.AsString(), "2")
+	}
+
+	// Disabled by default: the continuation row is kept as its own row
+	rows = NewDefaultRowParser().ParseRows(grid, headers, 0, boundary)
+	if len(rows) != 3 {
+		t.Errorf("ParseRows() without merging returned %d rows, want 3", len(rows))
+	}
+}
+
 func TestRowParser_ParseRows_PartialRows(t *testing.T) {
 	rp := NewDefaultRowParser()
 
@@ -1,6 +1,7 @@
 package reader
 
 import (
+	"slices"
 	"strconv"
 	"strings"
 	"time"
@@ -12,37 +13,89 @@ import (
 
 // SheetProcessor handles reading and processing individual sheets
 type SheetProcessor struct {
-	file   *ExcelFile
-	config models.DetectionConfig
+	file        *ExcelFile
+	config      models.DetectionConfig
+	quoteStyles map[int]bool // Styles marking cells as text, when StripTextMarker is set
 }
 
 // NewSheetProcessor creates a new sheet processor with default config
 func NewSheetProcessor(file *ExcelFile) *SheetProcessor {
-	return &SheetProcessor{
-		file:   file,
-		config: models.DefaultConfig(),
-	}
+	return NewSheetProcessorWithConfig(file, models.DefaultConfig())
 }
 
 // NewSheetProcessorWithConfig creates a new sheet processor with custom config
 func NewSheetProcessorWithConfig(file *ExcelFile, config models.DetectionConfig) *SheetProcessor {
-	return &SheetProcessor{
+	sp := &SheetProcessor{
 		file:   file,
 		config: config,
 	}
+	if config.StripTextMarker && file != nil && file.file != nil {
+		sp.quoteStyles = file.QuotePrefixStyles()
+	}
+	return sp
 }
 
 // ReadSheet reads all cells from a sheet into a 2D grid
 func (sp *SheetProcessor) ReadSheet(sheetName string) ([][]models.Cell, error) {
-	rows, err := sp.file.GetRows(sheetName)
+	var grid [][]models.Cell
+	var err error
+	if sp.config.StreamingSheetRead {
+		grid, err = sp.streamGrid(sheetName)
+	} else {
+		grid, err = sp.loadGrid(sheetName)
+	}
 	if err != nil {
 		return nil, err
 	}
 
-	if len(rows) == 0 {
+	if len(grid) == 0 {
 		return [][]models.Cell{}, nil
 	}
 
+	// Apply merge cell processing if enabled
+	if sp.config.ExpandMergedCells || sp.config.TrackMergeMetadata {
+		if err := sp.applyMerges(sheetName, grid); err != nil {
+			// Log warning but don't fail - merges are an enhancement
+			// The grid is still valid without merge info
+			_ = err
+		}
+	}
+
+	// Apply comments
+	if err := sp.applyComments(sheetName, grid); err != nil {
+		// Log warning but don't fail - comments are an enhancement
+		_ = err
+	}
+
+	// Apply hyperlinks
+	if err := sp.applyHyperlinks(sheetName, grid); err != nil {
+		// Log warning but don't fail - hyperlinks are an enhancement
+		_ = err
+	}
+
+	// Drop hidden rows and columns last so cell references above stay aligned
+	if sp.config.SkipHidden {
+		grid = sp.removeHidden(sheetName, grid)
+	}
+
+	return grid, nil
+}
+
+// loadGrid reads every row of a sheet and then builds the cell grid
+func (sp *SheetProcessor) loadGrid(sheetName string) ([][]models.Cell, error) {
+	rows, err := sp.file.GetRows(sheetName)
+	if err != nil {
+		return nil, err
+	}
+
+	for rowIdx := range rows {
+		rows[rowIdx] = sp.limitColumns(rows[rowIdx])
+	}
+	// Like GetRows, drop trailing rows left empty by the column limit
+	for len(rows) > 0 && len(rows[len(rows)-1]) == 0 {
+		rows = rows[:len(rows)-1]
+	}
+
 	// Find the maximum column count
 	maxCols := 0
 	for _, row := range rows {
@@ -52,6 +105,7 @@ func (sp *SheetProcessor) ReadSheet(sheetName string) ([][]models.Cell, error) {
 	}
 
 	// Build the cell grid
+	numFmts := make(map[int]string)
 	grid := make([][]models.Cell, len(rows))
 	for rowIdx, row := range rows {
 		grid[rowIdx] = make([]models.Cell, maxCols)
@@ -60,57 +114,223 @@ func (sp *SheetProcessor) ReadSheet(sheetName string) ([][]models.Cell, error) {
 			if colIdx < len(row) {
 				rawValue = row[colIdx]
 			}
+			grid[rowIdx][colIdx] = sp.readCell(sheetName, rowIdx, colIdx, rawValue, numFmts)
+		}
+	}
+	return grid, nil
+}
 
-			cellRef, _ := excelize.CoordinatesToCellName(colIdx+1, rowIdx+1)
-			cellType := sp.detectCellType(sheetName, cellRef, rawValue)
-			value := sp.parseValue(rawValue, cellType)
-
-			// Check for formula
-			var formula string
-			var hasFormula bool
-			if cellType == models.CellTypeFormula {
-				if f, err := sp.file.GetCellFormula(sheetName, cellRef); err == nil && f != "" {
-					formula = f
-					hasFormula = true
-				}
-			}
+// streamGrid builds the cell grid while iterating over the sheet's rows, so the
+// sheet's text is never held as a separate [][]string alongside the grid.
+// Rows are padded to the widest row once the last one has been read.
+func (sp *SheetProcessor) streamGrid(sheetName string) ([][]models.Cell, error) {
+	numFmts := make(map[int]string)
+	var grid [][]models.Cell
+	maxCols := 0
+	pendingEmpty := 0
+
+	err := sp.file.ForEachRow(sheetName, func(row []string) error {
+		row = sp.limitColumns(row)
+		// Like GetRows, keep empty rows only when a later row has values
+		if len(row) == 0 {
+			pendingEmpty++
+			return nil
+		}
+		for ; pendingEmpty > 0; pendingEmpty-- {
+			grid = append(grid, nil)
+		}
+
+		rowIdx := len(grid)
+		cells := make([]models.Cell, len(row))
+		for colIdx, rawValue := range row {
+			cells[colIdx] = sp.readCell(sheetName, rowIdx, colIdx, rawValue, numFmts)
+		}
+		grid = append(grid, cells)
+		if len(row) > maxCols {
+			maxCols = len(row)
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	for rowIdx := range grid {
+		for colIdx := len(grid[rowIdx]); colIdx < maxCols; colIdx++ {
+			grid[rowIdx] = append(grid[rowIdx], sp.readCell(sheetName, rowIdx, colIdx, "", numFmts))
+		}
+	}
+	return grid, nil
+}
+
+// limitColumns drops values beyond MaxScanColumns, along with the empty cells
+// that are then left at the end of the row
+func (sp *SheetProcessor) limitColumns(row []string) []string {
+	limit := sp.config.MaxScanColumns
+	if limit <= 0 || len(row) <= limit {
+		return row
+	}
+	row = row[:limit]
+	for len(row) > 0 && row[len(row)-1] == "" {
+		row = row[:len(row)-1]
+	}
+	return row
+}
+
+// readCell builds the cell at the given position from its displayed value
+func (sp *SheetProcessor) readCell(sheetName string, rowIdx, colIdx int, rawValue string, numFmts map[int]string) models.Cell {
+	cellRef, _ := excelize.CoordinatesToCellName(colIdx+1, rowIdx+1)
+
+	// Cells carrying Excel's text marker stay strings, without a leaked apostrophe
+	if rawValue != "" && sp.isQuotePrefixed(sheetName, cellRef) {
+		text := strings.TrimPrefix(rawValue, "'")
+		if text == "" {
+			return models.Cell{Type: models.CellTypeEmpty, Row: rowIdx, Col: colIdx}
+		}
+		return models.Cell{Value: text, Type: models.CellTypeString, Row: rowIdx, Col: colIdx, RawValue: text}
+	}
 
-			grid[rowIdx][colIdx] = models.Cell{
-				Value:      value,
-				Type:       cellType,
-				Row:        rowIdx,
-				Col:        colIdx,
-				RawValue:   rawValue,
-				IsMerged:   false,
-				MergeRange: nil,
-				Formula:    formula,
-				HasFormula: hasFormula,
+	cellType := sp.detectCellType(sheetName, cellRef, rawValue)
+	value := parseValue(rawValue, cellType)
+
+	// Percentage and currency cells are read as text; use the stored number
+	var numberFormat string
+	if rawValue != "" && cellType != models.CellTypeFormula {
+		numberFormat = sp.numberFormat(sheetName, cellRef, numFmts)
+		if _, isNumber := value.(float64); numberFormat != "" && !isNumber {
+			if f, ok := sp.formattedNumber(sheetName, cellRef, numberFormat, rawValue); ok {
+				cellType = models.CellTypeNumber
+				value = f
 			}
 		}
 	}
 
-	// Apply merge cell processing if enabled
-	if sp.config.ExpandMergedCells || sp.config.TrackMergeMetadata {
-		if err := sp.applyMerges(sheetName, grid); err != nil {
-			// Log warning but don't fail - merges are an enhancement
-			// The grid is still valid without merge info
-			_ = err
+	// Grouped numbers like "1.234,56" and words like "Yes" are text unless configured
+	if cellType == models.CellTypeString {
+		if f, ok := parseLocaleNumber(rawValue, sp.config.NumberLocale); ok {
+			cellType = models.CellTypeNumber
+			value = f
+		} else if b, ok := sp.configuredBool(rawValue); ok {
+			cellType = models.CellTypeBool
+			value = b
 		}
 	}
 
-	// Apply comments
-	if err := sp.applyComments(sheetName, grid); err != nil {
-		// Log warning but don't fail - comments are an enhancement
-		_ = err
+	// Check for formula
+	var formula string
+	var hasFormula bool
+	if cellType == models.CellTypeFormula {
+		if f, err := sp.file.GetCellFormula(sheetName, cellRef); err == nil && f != "" {
+			formula = f
+			hasFormula = true
+		}
 	}
 
-	// Apply hyperlinks
-	if err := sp.applyHyperlinks(sheetName, grid); err != nil {
-		// Log warning but don't fail - hyperlinks are an enhancement
-		_ = err
+	return models.Cell{
+		Value:        value,
+		Type:         cellType,
+		Row:          rowIdx,
+		Col:          colIdx,
+		RawValue:     rawValue,
+		IsMerged:     false,
+		MergeRange:   nil,
+		Formula:      formula,
+		HasFormula:   hasFormula,
+		NumberFormat: numberFormat,
 	}
+}
 
-	return grid, nil
+// forceStrings turns the cells in the ForceStringColumns and
+// ForceStringColumnIndexes columns of a table into strings. Cells keep their
+// displayed text, so a "00000" format keeps its leading zeros; General-format
+// numbers take the text stored in the file, which excelize may round or trim.
+func (sp *SheetProcessor) forceStrings(sheetName string, table *models.Table) {
+	if len(sp.config.ForceStringColumns) == 0 && len(sp.config.ForceStringColumnIndexes) == 0 {
+		return
+	}
+
+	for r := range table.Rows {
+		row := &table.Rows[r]
+		for i, header := range table.Headers {
+			if i >= len(row.Cells) {
+				break
+			}
+			cell := row.Cells[i]
+			if !slices.Contains(sp.config.ForceStringColumns, header) && !slices.Contains(sp.config.ForceStringColumnIndexes, cell.Col) {
+				continue
+			}
+			if cell.RawValue == "" || cell.Type == models.CellTypeString {
+				continue
+			}
+			text := cell.RawValue
+			if cell.Type == models.CellTypeNumber && isGeneralFormat(cell.NumberFormat) {
+				cellRef, _ := excelize.CoordinatesToCellName(cell.Col+1, cell.Row+1)
+				if raw, err := sp.file.GetCellRawValue(sheetName, cellRef); err == nil && raw != "" {
+					text = raw
+				}
+			}
+			cell.Value, cell.Type, cell.RawValue = text, models.CellTypeString, text
+			row.Cells[i] = cell
+			row.Values[header] = cell
+		}
+	}
+}
+
+// isQuotePrefixed reports whether a cell's style carries the quotePrefix text marker
+func (sp *SheetProcessor) isQuotePrefixed(sheetName, cellRef string) bool {
+	if len(sp.quoteStyles) == 0 {
+		return false
+	}
+	styleID, err := sp.file.GetCellStyle(sheetName, cellRef)
+	return err == nil && sp.quoteStyles[styleID]
+}
+
+// isGeneralFormat reports whether a number format code displays numbers as stored
+func isGeneralFormat(format string) bool {
+	return format == "" || strings.EqualFold(format, "General")
+}
+
+// removeHidden returns a grid without hidden rows and columns.
+// Cells keep their original Row and Col coordinates.
+func (sp *SheetProcessor) removeHidden(sheetName string, grid [][]models.Cell) [][]models.Cell {
+	if len(grid) == 0 {
+		return grid
+	}
+
+	// Determine visible columns
+	visibleCols := make([]int, 0, len(grid[0]))
+	for colIdx := range grid[0] {
+		colName, err := excelize.ColumnNumberToName(colIdx + 1)
+		if err != nil {
+			visibleCols = append(visibleCols, colIdx)
+			continue
+		}
+		if visible, err := sp.file.GetColVisible(sheetName, colName); err != nil || visible {
+			visibleCols = append(visibleCols, colIdx)
+		}
+	}
+
+	result := make([][]models.Cell, 0, len(grid))
+	for rowIdx, row := range grid {
+		if visible, err := sp.file.GetRowVisible(sheetName, rowIdx+1); err == nil && !visible {
+			continue
+		}
+
+		if len(visibleCols) == len(row) {
+			result = append(result, row)
+			continue
+		}
+
+		newRow := make([]models.Cell, 0, len(visibleCols))
+		for _, colIdx := range visibleCols {
+			if colIdx < len(row) {
+				newRow = append(newRow, row[colIdx])
+			}
+		}
+		result = append(result, newRow)
+	}
+
+	return result
 }
 
 // applyComments fetches and applies comment information to the grid
@@ -189,6 +409,9 @@ func (sp *SheetProcessor) detectCellType(sheetName, cellRef, value string) model
 	// Try to get cell type from excelize first - this is important for formulas
 	// which may have empty values when not evaluated
 	ct, err := sp.file.GetCellType(sheetName, cellRef)
+	if err == nil && sp.config.TrustExcelTypes {
+		return sp.excelCellType(sheetName, cellRef, ct, value)
+	}
 	if err == nil {
 		switch ct {
 		case excelize.CellTypeFormula:
@@ -215,55 +438,160 @@ func (sp *SheetProcessor) detectCellType(sheetName, cellRef, value string) model
 	return inferType(value)
 }
 
-// inferType infers the cell type from the raw value
-func inferType(value string) models.CellType {
-	if value == "" {
+// excelCellType maps the type recorded in the file to a cell type. Only shared
+// strings that look like dates and untyped non-numeric cells fall back to inference.
+func (sp *SheetProcessor) excelCellType(sheetName, cellRef string, ct excelize.CellType, value string) models.CellType {
+	if value == "" && ct != excelize.CellTypeFormula {
 		return models.CellTypeEmpty
 	}
 
-	// Check for boolean
-	lower := strings.ToLower(value)
-	if lower == "true" || lower == "false" {
+	switch ct {
+	case excelize.CellTypeFormula:
+		// t="str" is also used for plain text written by some tools
+		if f, err := sp.file.GetCellFormula(sheetName, cellRef); err == nil && f != "" {
+			return models.CellTypeFormula
+		}
+		if value == "" {
+			return models.CellTypeEmpty
+		}
+		return models.CellTypeString
+	case excelize.CellTypeBool:
 		return models.CellTypeBool
+	case excelize.CellTypeDate:
+		return models.CellTypeDate
+	case excelize.CellTypeNumber:
+		return models.CellTypeNumber
+	case excelize.CellTypeSharedString:
+		if isDateLike(value) {
+			return models.CellTypeDate
+		}
+		return models.CellTypeString
+	case excelize.CellTypeInlineString, excelize.CellTypeError:
+		return models.CellTypeString
 	}
 
-	// Check for number
+	// Untyped cells are numbers in the file format; infer only when the value says otherwise
 	if _, err := strconv.ParseFloat(value, 64); err == nil {
 		return models.CellTypeNumber
 	}
+	return inferType(value)
+}
 
-	// Check for date patterns
-	if isDateLike(value) {
-		return models.CellTypeDate
+// numberFormat returns the number format code of a cell, caching codes by style
+func (sp *SheetProcessor) numberFormat(sheetName, cellRef string, cache map[int]string) string {
+	styleID, err := sp.file.GetCellStyle(sheetName, cellRef)
+	if err != nil || styleID == 0 {
+		return ""
+	}
+	if format, ok := cache[styleID]; ok {
+		return format
 	}
+	format, err := sp.file.GetNumberFormat(styleID)
+	if err != nil {
+		format = ""
+	}
+	cache[styleID] = format
+	return format
+}
 
-	return models.CellTypeString
+// formattedNumber returns the stored number behind a percentage- or
+// currency-formatted cell whose displayed text is not a plain number
+func (sp *SheetProcessor) formattedNumber(sheetName, cellRef, numberFormat, displayed string) (float64, bool) {
+	if !strings.Contains(numberFormat, "%") && models.CurrencySymbol(numberFormat) == "" {
+		return 0, false
+	}
+	raw, err := sp.file.GetCellRawValue(sheetName, cellRef)
+	if err != nil || raw == displayed {
+		return 0, false
+	}
+	f, err := strconv.ParseFloat(raw, 64)
+	return f, err == nil
 }
 
-// isDateLike checks if a string looks like a date
-func isDateLike(value string) bool {
-	dateFormats := []string{
-		"2006-01-02",
-		"01/02/2006",
-		"02/01/2006",
-		"2006/01/02",
-		"Jan 2, 2006",
-		"January 2, 2006",
-		"02-Jan-2006",
-		"2006-01-02 15:04:05",
-		"01/02/2006 15:04:05",
-	}
-
-	for _, format := range dateFormats {
-		if _, err := time.Parse(format, value); err == nil {
-			return true
+// inferType infers the cell type from the raw value
+func inferType(value string) models.CellType {
+	_, cellType := models.InferValue(value)
+	return cellType
+}
+
+// configuredBool matches a value against BoolTrueValues and BoolFalseValues
+func (sp *SheetProcessor) configuredBool(value string) (bool, bool) {
+	value = strings.TrimSpace(value)
+	for _, v := range sp.config.BoolTrueValues {
+		if strings.EqualFold(value, v) {
+			return true, true
+		}
+	}
+	for _, v := range sp.config.BoolFalseValues {
+		if strings.EqualFold(value, v) {
+			return false, true
+		}
+	}
+	return false, false
+}
+
+// parseLocaleNumber parses a number written with the grouping and decimal
+// separators of locale. Groups after the first must have three digits.
+func parseLocaleNumber(value string, locale models.NumberLocale) (float64, bool) {
+	var group, decimal string
+	s := strings.TrimSpace(value)
+	switch locale {
+	case models.LocaleUS:
+		group, decimal = ",", "."
+	case models.LocaleEU:
+		group, decimal = ".", ","
+	case models.LocaleSpace:
+		group, decimal = " ", ","
+		s = strings.NewReplacer("\u00a0", " ", "\u202f", " ").Replace(s)
+	default:
+		return 0, false
+	}
+
+	sign := ""
+	if strings.HasPrefix(s, "-") || strings.HasPrefix(s, "+") {
+		sign, s = s[:1], s[1:]
+	}
+	intPart, frac, hasFrac := strings.Cut(s, decimal)
+	if hasFrac && (frac == "" || !isDigits(frac)) {
+		return 0, false
+	}
+
+	groups := strings.Split(intPart, group)
+	for i, g := range groups {
+		if !isDigits(g) || (i == 0 && len(groups) > 1 && len(g) > 3) || (i > 0 && len(g) != 3) {
+			return 0, false
+		}
+	}
+
+	number := sign + strings.Join(groups, "")
+	if hasFrac {
+		number += "." + frac
+	}
+	f, err := strconv.ParseFloat(number, 64)
+	return f, err == nil
+}
+
+// isDigits reports whether s is a non-empty run of ASCII digits
+func isDigits(s string) bool {
+	if s == "" {
+		return false
+	}
+	for i := 0; i < len(s); i++ {
+		if s[i] < '0' || s[i] > '9' {
+			return false
 		}
 	}
-	return false
+	return true
+}
+
+// isDateLike checks if a string looks like a date
+func isDateLike(value string) bool {
+	_, ok := models.ParseDate(value)
+	return ok
 }
 
 // parseValue converts a raw string value to the appropriate Go type
-func (sp *SheetProcessor) parseValue(value string, cellType models.CellType) interface{} {
+func parseValue(value string, cellType models.CellType) interface{} {
 	switch cellType {
 	case models.CellTypeEmpty:
 		return nil
@@ -281,24 +609,16 @@ func (sp *SheetProcessor) parseValue(value string, cellType models.CellType) int
 	}
 }
 
+// ParseDate parses text written in one of the date layouts the reader
+// recognises, such as "2006-01-02" or "Jan 2, 2006"
+func ParseDate(value string) (time.Time, bool) {
+	return models.ParseDate(strings.TrimSpace(value))
+}
+
 // parseDate attempts to parse a date string
 func parseDate(value string) interface{} {
-	dateFormats := []string{
-		"2006-01-02",
-		"01/02/2006",
-		"02/01/2006",
-		"2006/01/02",
-		"Jan 2, 2006",
-		"January 2, 2006",
-		"02-Jan-2006",
-		"2006-01-02 15:04:05",
-		"01/02/2006 15:04:05",
-	}
-
-	for _, format := range dateFormats {
-		if t, err := time.Parse(format, value); err == nil {
-			return t
-		}
+	if t, ok := models.ParseDate(value); ok {
+		return t
 	}
 	return value
 }
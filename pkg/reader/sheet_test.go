@@ -2,7 +2,9 @@ package reader
 
 import (
 	"path/filepath"
+	"reflect"
 	"testing"
+	"time"
 
 	"github.com/meddhiazoghlami/goxls/pkg/models"
 
@@ -138,6 +140,234 @@ func TestSheetProcessor_ReadSheet_MixedTypes(t *testing.T) {
 	}
 }
 
+func TestSheetProcessor_ReadSheet_TrustExcelTypes(t *testing.T) {
+	ef := createSheetTestFile(t, func(f *excelize.File) {
+		f.SetCellStr("Sheet1", "A1", "01234")
+		f.SetCellValue("Sheet1", "B1", 42)
+		f.SetCellValue("Sheet1", "C1", true)
+		f.SetCellValue("Sheet1", "D1", "2024-01-15")
+		f.SetCellDefault("Sheet1", "E1", "N/A") // t="str" without a formula
+	})
+	defer ef.Close()
+
+	config := models.DefaultConfig()
+	config.TrustExcelTypes = true
+	grid, err := NewSheetProcessorWithConfig(ef, config).ReadSheet("Sheet1")
+	if err != nil {
+		t.Fatalf("ReadSheet() error = %v", err)
+	}
+
+	tests := []struct {
+		col      int
+		expected models.CellType
+	}{
+		{0, models.CellTypeString},
+		{1, models.CellTypeNumber},
+		{2, models.CellTypeBool},
+		{3, models.CellTypeDate},
+		{4, models.CellTypeString},
+	}
+	for _, tt := range tests {
+		if cell := grid[0][tt.col]; cell.Type != tt.expected {
+			t.Errorf("grid[0][%d].Type = %v, want %v", tt.col, cell.Type, tt.expected)
+		}
+	}
+
+	zip := grid[0][0]
+	if zip.Value != "01234" {
+		t.Errorf("ZIP code Value = %v (%T), want string %q", zip.Value, zip.Value, "01234")
+	}
+	if text := grid[0][4]; text.HasFormula || text.Value != "N/A" {
+		t.Errorf("text cell = %v (formula %v), want plain string %q", text.Value, text.HasFormula, "N/A")
+	}
+}
+
+func TestSheetProcessor_ReadSheet_NumberFormats(t *testing.T) {
+	currencyFmt := `"$"#,##0.00`
+	ef := createSheetTestFile(t, func(f *excelize.File) {
+		percent, _ := f.NewStyle(&excelize.Style{NumFmt: 9})
+		currency, _ := f.NewStyle(&excelize.Style{CustomNumFmt: &currencyFmt})
+		f.SetCellValue("Sheet1", "A1", 0.5)
+		f.SetCellStyle("Sheet1", "A1", "A1", percent)
+		f.SetCellValue("Sheet1", "B1", 1234.5)
+		f.SetCellStyle("Sheet1", "B1", "B1", currency)
+		f.SetCellValue("Sheet1", "C1", 7)
+	})
+	defer ef.Close()
+
+	grid, err := NewSheetProcessor(ef).ReadSheet("Sheet1")
+	if err != nil {
+		t.Fatalf("ReadSheet() error = %v", err)
+	}
+
+	pct := grid[0][0]
+	if pct.RawValue != "50%" || pct.NumberFormat != "0%" {
+		t.Errorf("percent cell RawValue = %q, NumberFormat = %q, want %q, %q", pct.RawValue, pct.NumberFormat, "50%", "0%")
+	}
+	if v, ok := pct.AsFloat(); !ok || v != 0.5 {
+		t.Errorf("percent cell AsFloat() = %v, %v, want 0.5, true", v, ok)
+	}
+
+	cur := grid[0][1]
+	if cur.Type != models.CellTypeNumber {
+		t.Errorf("currency cell Type = %v, want %v", cur.Type, models.CellTypeNumber)
+	}
+	if v, symbol, ok := cur.AsCurrency(); !ok || v != 1234.5 || symbol != "$" {
+		t.Errorf("currency cell AsCurrency() = %v, %q, %v, want 1234.5, \"$\", true", v, symbol, ok)
+	}
+
+	if plain := grid[0][2]; plain.NumberFormat != "" {
+		t.Errorf("plain cell NumberFormat = %q, want empty", plain.NumberFormat)
+	}
+}
+
+func TestParseLocaleNumber(t *testing.T) {
+	tests := []struct {
+		value  string
+		locale models.NumberLocale
+		want   float64
+		wantOK bool
+	}{
+		{"1.234,56", models.LocaleEU, 1234.56, true},
+		{"-1.234.567", models.LocaleEU, -1234567, true},
+		{"0,5", models.LocaleEU, 0.5, true},
+		{"1,234.56", models.LocaleUS, 1234.56, true},
+		{"1 234,56", models.LocaleSpace, 1234.56, true},
+		{"1\u00a0234,56", models.LocaleSpace, 1234.56, true},
+		{"1.234,56", models.LocaleUS, 0, false},
+		{"1.234,56", models.LocaleNone, 0, false},
+		{"12.34,5", models.LocaleEU, 0, false},
+		{"1.2345", models.LocaleEU, 0, false},
+		{"1,", models.LocaleEU, 0, false},
+		{"abc", models.LocaleEU, 0, false},
+	}
+
+	for _, tt := range tests {
+		got, ok := parseLocaleNumber(tt.value, tt.locale)
+		if ok != tt.wantOK || got != tt.want {
+			t.Errorf("parseLocaleNumber(%q, %d) = %v, %v, want %v, %v", tt.value, tt.locale, got, ok, tt.want, tt.wantOK)
+		}
+	}
+}
+
+func TestSheetProcessor_ReadSheet_NumberLocale(t *testing.T) {
+	ef := createSheetTestFile(t, func(f *excelize.File) {
+		f.SetCellValue("Sheet1", "A1", "1.234,56")
+		f.SetCellValue("Sheet1", "B1", "Total")
+		f.SetCellValue("Sheet1", "C1", 42)
+	})
+	defer ef.Close()
+
+	config := models.DefaultConfig()
+	config.NumberLocale = models.LocaleEU
+	grid, err := NewSheetProcessorWithConfig(ef, config).ReadSheet("Sheet1")
+	if err != nil {
+		t.Fatalf("ReadSheet() error = %v", err)
+	}
+	if cell := grid[0][0]; cell.Type != models.CellTypeNumber || cell.Value != 1234.56 || cell.RawValue != "1.234,56" {
+		t.Errorf("EU cell = %v (%v, raw %q), want 1234.56 (number, raw %q)", cell.Value, cell.Type, cell.RawValue, "1.234,56")
+	}
+	if cell := grid[0][1]; cell.Type != models.CellTypeString {
+		t.Errorf("text cell Type = %v, want %v", cell.Type, models.CellTypeString)
+	}
+	if cell := grid[0][2]; cell.Value != float64(42) {
+		t.Errorf("plain number Value = %v, want 42", cell.Value)
+	}
+
+	config.NumberLocale = models.LocaleUS
+	grid, err = NewSheetProcessorWithConfig(ef, config).ReadSheet("Sheet1")
+	if err != nil {
+		t.Fatalf("ReadSheet() error = %v", err)
+	}
+	if cell := grid[0][0]; cell.Type != models.CellTypeString || cell.Value != "1.234,56" {
+		t.Errorf("US cell = %v (%v), want string 1.234,56", cell.Value, cell.Type)
+	}
+}
+
+func TestSheetProcessor_ReadSheet_MaxScanColumns(t *testing.T) {
+	ef := createSheetTestFile(t, func(f *excelize.File) {
+		f.SetSheetRow("Sheet1", "A1", &[]interface{}{"Name", "Score"})
+		f.SetSheetRow("Sheet1", "A2", &[]interface{}{"Alice", 90})
+		f.SetSheetRow("Sheet1", "A3", &[]interface{}{"Bob", 80})
+		f.SetCellValue("Sheet1", "ZZ2", "stray")
+		f.SetCellValue("Sheet1", "ZZ5", "stray")
+	})
+	defer ef.Close()
+
+	config := models.DefaultConfig()
+	rows, cols, err := NewSheetProcessorWithConfig(ef, config).GetDimensions("Sheet1")
+	if err != nil {
+		t.Fatalf("GetDimensions() error = %v", err)
+	}
+	if rows != 5 || cols != 702 {
+		t.Errorf("GetDimensions() without limit = %d x %d, want 5 x 702", rows, cols)
+	}
+
+	config.MaxScanColumns = 10
+	for _, streaming := range []bool{false, true} {
+		config.StreamingSheetRead = streaming
+		sp := NewSheetProcessorWithConfig(ef, config)
+		rows, cols, err := sp.GetDimensions("Sheet1")
+		if err != nil {
+			t.Fatalf("GetDimensions() error = %v", err)
+		}
+		if rows != 3 || cols != 2 {
+			t.Errorf("GetDimensions(streaming=%v) = %d x %d, want 3 x 2", streaming, rows, cols)
+		}
+		grid, err := sp.ReadSheet("Sheet1")
+		if err != nil {
+			t.Fatalf("ReadSheet() error = %v", err)
+		}
+		if grid[1][1].Value != float64(90) {
+			t.Errorf("grid[1][1] = %v, want 90", grid[1][1].Value)
+		}
+	}
+}
+
+func TestSheetProcessor_ReadSheet_StreamingMatchesLoaded(t *testing.T) {
+	ef := createSheetTestFile(t, func(f *excelize.File) {
+		percent, _ := f.NewStyle(&excelize.Style{NumFmt: 10})
+		f.SetSheetRow("Sheet1", "A1", &[]interface{}{"Name", "Score", "Share", "Joined"})
+		f.SetSheetRow("Sheet1", "A2", &[]interface{}{"Alice", 90, 0.25, "2024-01-15"})
+		f.SetSheetRow("Sheet1", "A3", &[]interface{}{"Bob", 80})
+		f.SetCellStyle("Sheet1", "C2", "C2", percent)
+		f.SetCellFormula("Sheet1", "B4", "SUM(B2:B3)")
+		// Gap rows, a wider row and a trailing merge, comment and hyperlink
+		f.SetCellValue("Sheet1", "F7", "far")
+		f.MergeCell("Sheet1", "A7", "B7")
+		f.SetCellValue("Sheet1", "A7", "merged")
+		f.AddComment("Sheet1", excelize.Comment{Cell: "A2", Author: "t", Text: "note"})
+		f.SetCellHyperLink("Sheet1", "A3", "https://example.com", "External")
+	})
+	defer ef.Close()
+
+	loaded, err := NewSheetProcessor(ef).ReadSheet("Sheet1")
+	if err != nil {
+		t.Fatalf("ReadSheet() error = %v", err)
+	}
+
+	config := models.DefaultConfig()
+	config.StreamingSheetRead = true
+	streamed, err := NewSheetProcessorWithConfig(ef, config).ReadSheet("Sheet1")
+	if err != nil {
+		t.Fatalf("ReadSheet() streaming error = %v", err)
+	}
+
+	if len(streamed) != len(loaded) {
+		t.Fatalf("streaming rows = %d, want %d", len(streamed), len(loaded))
+	}
+	for r := range loaded {
+		if len(streamed[r]) != len(loaded[r]) {
+			t.Fatalf("row %d: streaming cols = %d, want %d", r, len(streamed[r]), len(loaded[r]))
+		}
+		for c := range loaded[r] {
+			if !reflect.DeepEqual(streamed[r][c], loaded[r][c]) {
+				t.Errorf("cell (%d,%d) streaming = %+v, want %+v", r, c, streamed[r][c], loaded[r][c])
+			}
+		}
+	}
+}
+
 func TestSheetProcessor_ReadSheet_JaggedRows(t *testing.T) {
 	ef := createSheetTestFile(t, func(f *excelize.File) {
 		f.SetCellValue("Sheet1", "A1", "A")
@@ -964,6 +1194,35 @@ func TestSheetProcessor_ReadSheet_MergeDisabled(t *testing.T) {
 	}
 }
 
+func TestSheetProcessor_ReadSheet_MergeFillOriginOnly(t *testing.T) {
+	ef := createSheetTestFile(t, func(f *excelize.File) {
+		f.SetCellValue("Sheet1", "A1", "Quarter")
+		f.MergeCell("Sheet1", "A1", "C1")
+		f.SetSheetRow("Sheet1", "A2", &[]interface{}{"Jan", "Feb", "Mar"})
+	})
+	defer ef.Close()
+
+	config := models.DefaultConfig()
+	config.MergeFill = models.FillOriginOnly
+	grid, err := NewSheetProcessorWithConfig(ef, config).ReadSheet("Sheet1")
+	if err != nil {
+		t.Fatalf("ReadSheet() error = %v", err)
+	}
+
+	if grid[0][0].RawValue != "Quarter" {
+		t.Errorf("origin RawValue = %q, want Quarter", grid[0][0].RawValue)
+	}
+	for col := 0; col < 3; col++ {
+		cell := grid[0][col]
+		if !cell.IsMerged {
+			t.Errorf("Cell[0][%d].IsMerged = false, want true", col)
+		}
+		if col > 0 && (cell.RawValue != "" || cell.Type != models.CellTypeEmpty) {
+			t.Errorf("Cell[0][%d] = %q (%v), want empty", col, cell.RawValue, cell.Type)
+		}
+	}
+}
+
 func TestSheetProcessor_ReadSheet_RectangularMerge(t *testing.T) {
 	ef := createSheetTestFile(t, func(f *excelize.File) {
 		f.SetCellValue("Sheet1", "A1", "Big Block")
@@ -1407,3 +1666,59 @@ func TestSheetProcessor_ReadSheet_HyperlinksWithMergedCells(t *testing.T) {
 		t.Error("Cell[0][2].HasHyperlink = true, want false (hyperlink only on origin)")
 	}
 }
+
+// =============================================================================
+// Hidden Rows/Columns Tests
+// =============================================================================
+
+func TestSheetProcessor_ReadSheet_SkipHiddenColumns(t *testing.T) {
+	ef := createSheetTestFile(t, func(f *excelize.File) {
+		f.SetCellValue("Sheet1", "A1", "Name")
+		f.SetCellValue("Sheet1", "B1", "Internal")
+		f.SetCellValue("Sheet1", "C1", "Age")
+		f.SetCellValue("Sheet1", "A2", "Alice")
+		f.SetCellValue("Sheet1", "B2", "x")
+		f.SetCellValue("Sheet1", "C2", 30)
+		f.SetColVisible("Sheet1", "B", false)
+	})
+	defer ef.Close()
+
+	config := models.DefaultConfig()
+	config.SkipHidden = true
+	grid, err := NewSheetProcessorWithConfig(ef, config).ReadSheet("Sheet1")
+	if err != nil {
+		t.Fatalf("ReadSheet() error = %v", err)
+	}
+
+	if len(grid[0]) != 2 {
+		t.Fatalf("Expected 2 visible columns, got %d", len(grid[0]))
+	}
+	if grid[0][1].RawValue != "Age" {
+		t.Errorf("grid[0][1] = %q, want %q", grid[0][1].RawValue, "Age")
+	}
+	// Original coordinates are preserved
+	if grid[0][1].Col != 2 {
+		t.Errorf("grid[0][1].Col = %d, want 2", grid[0][1].Col)
+	}
+
+	// Without the flag the hidden column is kept
+	grid, err = NewSheetProcessor(ef).ReadSheet("Sheet1")
+	if err != nil {
+		t.Fatalf("ReadSheet() error = %v", err)
+	}
+	if len(grid[0]) != 3 {
+		t.Errorf("Expected 3 columns without SkipHidden, got %d", len(grid[0]))
+	}
+}
+
+func TestParseDate(t *testing.T) {
+	want := time.Date(2024, 3, 15, 0, 0, 0, 0, time.UTC)
+	for _, value := range []string{"2024-03-15", " 03/15/2024 ", "Mar 15, 2024", "15-Mar-2024"} {
+		if got, ok := ParseDate(value); !ok || !got.Equal(want) {
+			t.Errorf("ParseDate(%q) = %v, %v, want %v", value, got, ok, want)
+		}
+	}
+	if _, ok := ParseDate("next tuesday"); ok {
+		t.Error("ParseDate(\"next tuesday\") ok = true, want false")
+	}
+}
@@ -2,17 +2,27 @@ package reader
 
 import (
 	"fmt"
+	"sort"
+	"strings"
 	"sync"
 
 	"github.com/meddhiazoghlami/goxls/pkg/models"
 )
 
+// ProgressFunc is called after each sheet has been processed.
+// done is the number of sheets finished so far and total is the sheet count.
+type ProgressFunc func(sheetName string, done, total int)
+
 // WorkbookReader is the main entry point for reading Excel files
 type WorkbookReader struct {
 	config         models.DetectionConfig
 	analyzer       *TableAnalyzer
 	headerDetector *HeaderDetector
 	rowParser      *RowParser
+	progress       ProgressFunc
+	progressMu     sync.Mutex
+	sheets         []string
+	password       string
 }
 
 // NewWorkbookReader creates a new workbook reader with default config
@@ -36,10 +46,89 @@ func NewWorkbookReaderWithConfig(config models.DetectionConfig) *WorkbookReader
 	}
 }
 
+// SetProgress registers a callback invoked as each sheet finishes processing.
+// Pass nil to disable progress reporting.
+func (wr *WorkbookReader) SetProgress(fn ProgressFunc) {
+	wr.progress = fn
+}
+
+// SetSheets restricts reading to the named sheets. Sheets are still returned
+// in workbook order. Pass no names to read every sheet.
+func (wr *WorkbookReader) SetSheets(names ...string) {
+	wr.sheets = names
+}
+
+// SetPassword sets the password used to open protected workbooks
+func (wr *WorkbookReader) SetPassword(password string) {
+	wr.password = password
+}
+
+// sheetTarget identifies a sheet selected for processing
+type sheetTarget struct {
+	name       string
+	index      int // Position in the workbook
+	visibility models.SheetVisibility
+}
+
+// targetSheets returns the sheets to process in workbook order.
+// Hidden sheets are dropped when SkipHiddenSheets is set.
+func (wr *WorkbookReader) targetSheets(excelFile *ExcelFile) ([]sheetTarget, error) {
+	allNames := excelFile.GetSheetNames()
+
+	wanted := make(map[string]bool, len(wr.sheets))
+	for _, name := range wr.sheets {
+		wanted[name] = true
+	}
+
+	targets := make([]sheetTarget, 0, len(allNames))
+	for i, name := range allNames {
+		if len(wr.sheets) > 0 {
+			if !wanted[name] {
+				continue
+			}
+			delete(wanted, name)
+		}
+
+		visibility, err := excelFile.GetSheetVisibility(name)
+		if err != nil {
+			return nil, fmt.Errorf("failed to get visibility of sheet '%s': %w", name, err)
+		}
+		if wr.config.SkipHiddenSheets && visibility != models.VisibilityVisible {
+			continue
+		}
+		targets = append(targets, sheetTarget{name: name, index: i, visibility: visibility})
+	}
+
+	for _, name := range wr.sheets {
+		if wanted[name] {
+			return nil, fmt.Errorf("sheet '%s' not found", name)
+		}
+	}
+
+	return targets, nil
+}
+
+// progressReporter returns a function that reports each finished sheet of a
+// read to the progress callback. Every read gets its own counter, so reads
+// sharing the reader count separately; calls are serialized so that done
+// increases monotonically during parallel reads.
+func (wr *WorkbookReader) progressReporter(total int) func(sheetName string) {
+	done := 0
+	return func(sheetName string) {
+		if wr.progress == nil {
+			return
+		}
+		wr.progressMu.Lock()
+		defer wr.progressMu.Unlock()
+		done++
+		wr.progress(sheetName, done, total)
+	}
+}
+
 // ReadFile reads an Excel file and extracts all tables from all sheets
 func (wr *WorkbookReader) ReadFile(filePath string) (*models.Workbook, error) {
 	// Load the file
-	excelFile, err := LoadFile(filePath)
+	excelFile, err := LoadFileWithPassword(filePath, wr.password)
 	if err != nil {
 		return nil, fmt.Errorf("failed to load file: %w", err)
 	}
@@ -52,7 +141,7 @@ func (wr *WorkbookReader) ReadFile(filePath string) (*models.Workbook, error) {
 // This is more efficient for workbooks with multiple sheets
 func (wr *WorkbookReader) ReadFileParallel(filePath string) (*models.Workbook, error) {
 	// Load the file
-	excelFile, err := LoadFile(filePath)
+	excelFile, err := LoadFileWithPassword(filePath, wr.password)
 	if err != nil {
 		return nil, fmt.Errorf("failed to load file: %w", err)
 	}
@@ -61,15 +150,33 @@ func (wr *WorkbookReader) ReadFileParallel(filePath string) (*models.Workbook, e
 	return wr.processFileParallel(excelFile, filePath)
 }
 
+// ReadFiles reads several Excel files into a single workbook
+// Each sheet records the file it came from in SourceFile
+func (wr *WorkbookReader) ReadFiles(filePaths ...string) (*models.Workbook, error) {
+	workbooks := make([]*models.Workbook, 0, len(filePaths))
+	for _, filePath := range filePaths {
+		wb, err := wr.ReadFile(filePath)
+		if err != nil {
+			return nil, fmt.Errorf("failed to read '%s': %w", filePath, err)
+		}
+		workbooks = append(workbooks, wb)
+	}
+	return (&models.Workbook{}).Merge(workbooks...), nil
+}
+
 // processFileParallel processes sheets concurrently
 func (wr *WorkbookReader) processFileParallel(excelFile *ExcelFile, filePath string) (*models.Workbook, error) {
-	sheetNames := excelFile.GetSheetNames()
-	numSheets := len(sheetNames)
+	targets, err := wr.targetSheets(excelFile)
+	if err != nil {
+		return nil, err
+	}
+	numSheets := len(targets)
 
 	if numSheets == 0 {
 		return &models.Workbook{
-			FilePath: filePath,
-			Sheets:   []models.Sheet{},
+			FilePath:   filePath,
+			Sheets:     []models.Sheet{},
+			Properties: excelFile.GetProperties(),
 		}, nil
 	}
 
@@ -78,6 +185,8 @@ func (wr *WorkbookReader) processFileParallel(excelFile *ExcelFile, filePath str
 		return wr.processFile(excelFile, filePath)
 	}
 
+	reportProgress := wr.progressReporter(numSheets)
+
 	// Pre-allocate results slice to maintain sheet order
 	results := make([]models.Sheet, numSheets)
 	errors := make([]error, numSheets)
@@ -86,21 +195,24 @@ func (wr *WorkbookReader) processFileParallel(excelFile *ExcelFile, filePath str
 	wg.Add(numSheets)
 
 	// Process each sheet in a goroutine
-	for idx, sheetName := range sheetNames {
-		go func(idx int, sheetName string) {
+	for idx, target := range targets {
+		go func(idx int, target sheetTarget) {
 			defer wg.Done()
 
 			// Each goroutine needs its own sheet processor to avoid race conditions
 			// but they can share the same underlying file since reads are safe
 			sheetProcessor := NewSheetProcessorWithConfig(excelFile, wr.config)
 
-			sheet, err := wr.processSheet(sheetProcessor, sheetName, idx)
+			sheet, err := wr.processSheet(sheetProcessor, target.name, target.index)
 			if err != nil {
-				errors[idx] = fmt.Errorf("failed to process sheet '%s': %w", sheetName, err)
+				errors[idx] = fmt.Errorf("failed to process sheet '%s': %w", target.name, err)
 				return
 			}
+			sheet.Visibility = target.visibility
+			sheet.SourceFile = filePath
 			results[idx] = sheet
-		}(idx, sheetName)
+			reportProgress(target.name)
+		}(idx, target)
 	}
 
 	// Wait for all goroutines to complete
@@ -114,28 +226,37 @@ func (wr *WorkbookReader) processFileParallel(excelFile *ExcelFile, filePath str
 	}
 
 	return &models.Workbook{
-		FilePath: filePath,
-		Sheets:   results,
+		FilePath:   filePath,
+		Sheets:     results,
+		Properties: excelFile.GetProperties(),
 	}, nil
 }
 
 // processFile processes a loaded Excel file
 func (wr *WorkbookReader) processFile(excelFile *ExcelFile, filePath string) (*models.Workbook, error) {
 	workbook := &models.Workbook{
-		FilePath: filePath,
-		Sheets:   make([]models.Sheet, 0),
+		FilePath:   filePath,
+		Sheets:     make([]models.Sheet, 0),
+		Properties: excelFile.GetProperties(),
 	}
 
 	// Use config-aware sheet processor for merge cell support
 	sheetProcessor := NewSheetProcessorWithConfig(excelFile, wr.config)
-	sheetNames := excelFile.GetSheetNames()
+	targets, err := wr.targetSheets(excelFile)
+	if err != nil {
+		return nil, err
+	}
+	reportProgress := wr.progressReporter(len(targets))
 
-	for idx, sheetName := range sheetNames {
-		sheet, err := wr.processSheet(sheetProcessor, sheetName, idx)
+	for _, target := range targets {
+		sheet, err := wr.processSheet(sheetProcessor, target.name, target.index)
 		if err != nil {
-			return nil, fmt.Errorf("failed to process sheet '%s': %w", sheetName, err)
+			return nil, fmt.Errorf("failed to process sheet '%s': %w", target.name, err)
 		}
+		sheet.Visibility = target.visibility
+		sheet.SourceFile = filePath
 		workbook.Sheets = append(workbook.Sheets, sheet)
+		reportProgress(target.name)
 	}
 
 	return workbook, nil
@@ -155,39 +276,328 @@ func (wr *WorkbookReader) processSheet(processor *SheetProcessor, sheetName stri
 		return sheet, err
 	}
 
+	// Ranges defined in the file are an enhancement; detection works without them
+	if refs, err := processor.file.GetExcelTables(sheetName); err == nil && len(refs) > 0 {
+		sheet.ExcelTables = refs
+	}
+	if rows, cols, err := processor.file.GetFrozenPanes(sheetName); err == nil {
+		sheet.FrozenRows, sheet.FrozenCols = rows, cols
+	}
+	sheet.PrintArea = processor.file.GetPrintArea(sheetName)
+
 	if len(grid) == 0 {
 		return sheet, nil
 	}
 
 	// Detect tables in the grid
 	boundaries := wr.analyzer.DetectTables(grid)
+	names := make([]string, len(boundaries))
+
+	// Hidden rows and columns are dropped from the grid, so sheet ranges no longer line up
+	if wr.config.PreferNativeTables && !wr.config.SkipHidden {
+		boundaries, names = preferNativeTables(grid, boundaries, sheet.ExcelTables)
+	}
 
 	for i, boundary := range boundaries {
+		// A title inside the detected region would otherwise be read as the header
+		var title string
+		if wr.config.CaptureTitles {
+			title, boundary = splitTitle(grid, boundary)
+		}
 		table := wr.processTable(grid, boundary, sheetName, i+1)
+		processor.forceStrings(sheetName, &table)
+		if names[i] != "" {
+			table.Name = names[i]
+		}
+		if wr.config.CaptureTitles && !table.Transposed {
+			if title == "" {
+				title = wr.findTitle(grid, table)
+			}
+			table.Title = title
+		}
+		if !wr.acceptTable(&table) {
+			continue
+		}
 		sheet.Tables = append(sheet.Tables, table)
 	}
 
 	return sheet, nil
 }
 
+// acceptTable reports whether a detected table passes the
+// IgnoreTablesWithoutHeaders keywords and the AcceptTable predicate
+func (wr *WorkbookReader) acceptTable(table *models.Table) bool {
+	if !hasHeaderKeyword(table.Headers, wr.config.IgnoreTablesWithoutHeaders) {
+		return false
+	}
+	return wr.config.AcceptTable == nil || wr.config.AcceptTable(table)
+}
+
+// hasHeaderKeyword reports whether a header contains one of the keywords,
+// ignoring case. Blank keywords are skipped, and with none left every table
+// matches.
+func hasHeaderKeyword(headers, keywords []string) bool {
+	checked := false
+	for _, keyword := range keywords {
+		if strings.TrimSpace(keyword) == "" {
+			continue
+		}
+		keyword = strings.ToLower(keyword)
+		checked = true
+		for _, header := range headers {
+			if strings.Contains(strings.ToLower(header), keyword) {
+				return true
+			}
+		}
+	}
+	return !checked
+}
+
+// findTitle returns the text of the nearest non-empty row above a table's
+// header when that row holds a single value, such as a report title.
+// At most MaxEmptyRows blank rows may separate the title from the header.
+func (wr *WorkbookReader) findTitle(grid [][]models.Cell, table models.Table) string {
+	blank := 0
+	for row := table.HeaderRow - 1; row >= 0 && row < len(grid); row-- {
+		values := rowValues(grid, row, 0, table.EndCol)
+		switch len(values) {
+		case 0:
+			blank++
+			if blank > wr.config.MaxEmptyRows {
+				return ""
+			}
+		case 1:
+			return values[0]
+		default:
+			return ""
+		}
+	}
+	return ""
+}
+
+// splitTitle detects a title on the first row of a boundary, e.g. a caption
+// merged across the table, and returns it with the boundary starting at the
+// next non-empty row. The boundary is unchanged if the first row is not a
+// lone value followed by a row with several values.
+func splitTitle(grid [][]models.Cell, boundary models.TableBoundary) (string, models.TableBoundary) {
+	first := rowValues(grid, boundary.StartRow, boundary.StartCol, boundary.EndCol)
+	if len(first) != 1 {
+		return "", boundary
+	}
+	for row := boundary.StartRow + 1; row <= boundary.EndRow && row < len(grid); row++ {
+		n := len(rowValues(grid, row, boundary.StartCol, boundary.EndCol))
+		if n == 0 {
+			continue
+		}
+		if n > 1 {
+			rest := boundary
+			rest.StartRow = row
+			return first[0], rest
+		}
+		break
+	}
+	return "", boundary
+}
+
+// rowValues returns the non-empty values in a row between two columns.
+// Cells covered by an expanded merge count once, at the merge origin.
+func rowValues(grid [][]models.Cell, row, startCol, endCol int) []string {
+	var values []string
+	if row < 0 || row >= len(grid) {
+		return values
+	}
+	for col := startCol; col <= endCol && col < len(grid[row]); col++ {
+		cell := grid[row][col]
+		if cell.MergeRange != nil && !cell.MergeRange.IsOrigin {
+			continue
+		}
+		if v := strings.TrimSpace(cell.RawValue); v != "" {
+			values = append(values, v)
+		}
+	}
+	return values
+}
+
+// preferNativeTables replaces detected boundaries with the ranges the file
+// defines. Detected tables that overlap no native range are kept, and an
+// AutoFilter is only used where no Excel Table covers it. It returns the
+// boundaries in sheet order with the ListObject name for each, or "".
+func preferNativeTables(grid [][]models.Cell, detected []models.TableBoundary, refs []models.ExcelTableRef) ([]models.TableBoundary, []string) {
+	type namedBoundary struct {
+		boundary models.TableBoundary
+		name     string
+	}
+
+	var native []models.TableBoundary
+	var tables []namedBoundary
+	for _, ref := range refs {
+		boundary, ok := clipRange(grid, ref.Range)
+		if !ok || (ref.AutoFilter && overlapsAny(boundary, native)) {
+			continue
+		}
+		native = append(native, boundary)
+		tables = append(tables, namedBoundary{boundary, ref.Name})
+	}
+	if len(native) == 0 {
+		return detected, make([]string, len(detected))
+	}
+
+	for _, boundary := range detected {
+		if !overlapsAny(boundary, native) {
+			tables = append(tables, namedBoundary{boundary: boundary})
+		}
+	}
+	sort.SliceStable(tables, func(i, j int) bool {
+		if tables[i].boundary.StartRow != tables[j].boundary.StartRow {
+			return tables[i].boundary.StartRow < tables[j].boundary.StartRow
+		}
+		return tables[i].boundary.StartCol < tables[j].boundary.StartCol
+	})
+
+	boundaries := make([]models.TableBoundary, len(tables))
+	names := make([]string, len(tables))
+	for i, t := range tables {
+		boundaries[i] = t.boundary
+		names[i] = t.name
+	}
+	return boundaries, names
+}
+
+// clipRange parses a range such as "A1:D20" into a boundary within the grid
+func clipRange(grid [][]models.Cell, cellRange string) (models.TableBoundary, bool) {
+	parts := strings.Split(cellRange, ":")
+	if len(parts) != 2 || len(grid) == 0 {
+		return models.TableBoundary{}, false
+	}
+	startCol, startRow, err := parseCellRef(parts[0])
+	if err != nil {
+		return models.TableBoundary{}, false
+	}
+	endCol, endRow, err := parseCellRef(parts[1])
+	if err != nil {
+		return models.TableBoundary{}, false
+	}
+
+	boundary := models.TableBoundary{StartRow: startRow, EndRow: endRow, StartCol: startCol, EndCol: endCol}
+	if boundary.EndRow >= len(grid) {
+		boundary.EndRow = len(grid) - 1
+	}
+	if boundary.EndCol >= len(grid[0]) {
+		boundary.EndCol = len(grid[0]) - 1
+	}
+	if boundary.StartRow > boundary.EndRow || boundary.StartCol > boundary.EndCol {
+		return models.TableBoundary{}, false
+	}
+	return boundary, true
+}
+
+// overlapsAny reports whether b shares any cell with one of the boundaries
+func overlapsAny(b models.TableBoundary, boundaries []models.TableBoundary) bool {
+	for _, o := range boundaries {
+		if b.StartRow <= o.EndRow && o.StartRow <= b.EndRow && b.StartCol <= o.EndCol && o.StartCol <= b.EndCol {
+			return true
+		}
+	}
+	return false
+}
+
 // processTable processes a single table boundary and extracts data
 func (wr *WorkbookReader) processTable(grid [][]models.Cell, boundary models.TableBoundary, sheetName string, tableNum int) models.Table {
+	if wr.config.DetectTransposed && wr.analyzer.IsTransposed(grid, boundary) {
+		return wr.processTransposedTable(grid, boundary, sheetName, tableNum)
+	}
+
 	// Detect header row
 	headerRow := wr.headerDetector.DetectHeaderRow(grid, boundary)
 
 	// Extract headers
 	headers := wr.headerDetector.ExtractHeaders(grid, headerRow, boundary)
 
-	// Generate table name
-	tableName := fmt.Sprintf("%s_Table%d", sheetName, tableNum)
+	// Flatten multi-row headers; data then starts after the last header row
+	if wr.config.FlattenHierarchicalHeaders || wr.config.CoalesceHeaderWords {
+		headers, headerRow = wr.flattenHeaders(grid, boundary, headers, headerRow)
+	}
 
 	// Parse the table
-	return wr.rowParser.ParseTable(grid, boundary, headers, headerRow, tableName)
+	return wr.rowParser.ParseTable(grid, boundary, headers, headerRow, wr.tableName(sheetName, tableNum, headers))
+}
+
+// tableName names a detected table using the configured TableNamer, falling
+// back to "<sheet>_Table<n>"
+func (wr *WorkbookReader) tableName(sheetName string, tableNum int, headers []string) string {
+	if wr.config.TableNamer != nil {
+		if name := wr.config.TableNamer(sheetName, tableNum, headers); name != "" {
+			return name
+		}
+	}
+	return fmt.Sprintf("%s_Table%d", sheetName, tableNum)
+}
+
+// processTransposedTable parses a region with headers down its first column,
+// turning each following column into a row
+func (wr *WorkbookReader) processTransposedTable(grid [][]models.Cell, boundary models.TableBoundary, sheetName string, tableNum int) models.Table {
+	transposed, tBoundary := TransposeRegion(grid, boundary)
+	headers := wr.headerDetector.ExtractHeaders(transposed, 0, tBoundary)
+	table := wr.rowParser.ParseTable(transposed, tBoundary, headers, 0, wr.tableName(sheetName, tableNum, headers))
+
+	// Report the region's position in the sheet rather than in the transposed grid
+	table.StartRow = boundary.StartRow
+	table.EndRow = boundary.EndRow
+	table.StartCol = boundary.StartCol
+	table.EndCol = boundary.EndCol
+	table.HeaderRow = boundary.StartRow
+	table.Transposed = true
+	return table
+}
+
+// flattenHeaders combines a multi-row header around headerRow into single names,
+// either merged group headers or, with CoalesceHeaderWords, words stacked over two rows.
+// The detected header row may be the last level (sub-headers score higher than
+// merged group labels), so group rows just above it are considered too.
+// It returns the flattened headers and the last header row, or the inputs
+// unchanged when the header spans a single row.
+func (wr *WorkbookReader) flattenHeaders(grid [][]models.Cell, boundary models.TableBoundary, headers []string, headerRow int) ([]string, int) {
+	headerStart, headerEnd := headerRow, headerRow
+	for start := maxInt(boundary.StartRow, headerRow-2); start <= headerRow; start++ {
+		headerBoundary := boundary
+		headerBoundary.StartRow = start
+		s, e := wr.headerDetector.detectHeaderRows(grid, headerBoundary, true)
+		if e > s && e >= headerRow {
+			headerStart, headerEnd = s, e
+			break
+		}
+	}
+	if headerEnd <= headerStart {
+		return headers, headerRow
+	}
+
+	stacked := wr.config.CoalesceHeaderWords && wr.headerDetector.isStackedHeader(grid, headerStart, boundary)
+	if !stacked && !wr.config.FlattenHierarchicalHeaders {
+		return headers, headerRow
+	}
+
+	separator := wr.config.HeaderSeparator
+	if separator == "" {
+		separator = models.DefaultHeaderSeparator
+	}
+	if stacked {
+		// Stacked words form one name, e.g. "First" over "Name"
+		separator = " "
+	}
+
+	levels := wr.headerDetector.extractHeaderLevels(grid, headerStart, headerEnd, boundary, true)
+	flattened := wr.headerDetector.FlattenHierarchicalHeaders(levels, separator)
+
+	usedNames := make(map[string]int)
+	for i, name := range flattened {
+		flattened[i] = wr.headerDetector.normalizeHeader(name, boundary.StartCol+i, usedNames)
+	}
+	return flattened, headerEnd
 }
 
 // ReadSheet reads a single sheet by name
 func (wr *WorkbookReader) ReadSheet(filePath, sheetName string) (*models.Sheet, error) {
-	excelFile, err := LoadFile(filePath)
+	excelFile, err := LoadFileWithPassword(filePath, wr.password)
 	if err != nil {
 		return nil, fmt.Errorf("failed to load file: %w", err)
 	}
@@ -213,6 +623,10 @@ func (wr *WorkbookReader) ReadSheet(filePath, sheetName string) (*models.Sheet,
 	if err != nil {
 		return nil, err
 	}
+	if sheet.Visibility, err = excelFile.GetSheetVisibility(sheetName); err != nil {
+		return nil, err
+	}
+	sheet.SourceFile = filePath
 
 	return &sheet, nil
 }
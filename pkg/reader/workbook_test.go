@@ -1,8 +1,12 @@
 package reader
 
 import (
+	"fmt"
 	"path/filepath"
+	"strings"
+	"sync"
 	"testing"
+	"time"
 
 	"github.com/meddhiazoghlami/goxls/pkg/models"
 
@@ -246,6 +250,75 @@ func TestWorkbookReader_ReadFile_OffsetTable(t *testing.T) {
 	}
 }
 
+func TestWorkbookReader_ReadFile_HeaderCells(t *testing.T) {
+	path := createWorkbookTestFile(t, func(f *excelize.File) {
+		// Table starting at C4
+		f.SetCellValue("Sheet1", "C4", "Name")
+		f.SetCellValue("Sheet1", "D4", "Value")
+		f.SetCellValue("Sheet1", "C5", "Item1")
+		f.SetCellValue("Sheet1", "D5", "100")
+		f.SetCellValue("Sheet1", "C6", "Item2")
+		f.SetCellValue("Sheet1", "D6", "200")
+	})
+
+	wb, err := NewWorkbookReader().ReadFile(path)
+	if err != nil {
+		t.Fatalf("ReadFile() error = %v", err)
+	}
+	if len(wb.Sheets[0].Tables) != 1 {
+		t.Fatalf("Expected 1 table, got %d", len(wb.Sheets[0].Tables))
+	}
+
+	table := wb.Sheets[0].Tables[0]
+	if len(table.HeaderCells) != len(table.Headers) {
+		t.Fatalf("len(HeaderCells) = %d, want %d", len(table.HeaderCells), len(table.Headers))
+	}
+
+	cell, ok := table.HeaderCell("Value")
+	if !ok {
+		t.Fatal("HeaderCell(Value) not found")
+	}
+	ref, _ := excelize.CoordinatesToCellName(cell.Col+1, cell.Row+1)
+	if ref != "D4" {
+		t.Errorf("HeaderCell(Value) at %s, want D4", ref)
+	}
+	if cell.RawValue != "Value" {
+		t.Errorf("HeaderCell(Value).RawValue = %q, want Value", cell.RawValue)
+	}
+}
+
+func TestWorkbookReader_ReadFile_TableSeparatorRows(t *testing.T) {
+	path := createWorkbookTestFile(t, func(f *excelize.File) {
+		f.SetCellValue("Sheet1", "A1", "Name")
+		f.SetCellValue("Sheet1", "B1", "Value")
+		f.SetCellValue("Sheet1", "A2", "Item1")
+		f.SetCellValue("Sheet1", "B2", "100")
+
+		// Second table after 3 blank rows
+		f.SetCellValue("Sheet1", "A6", "Code")
+		f.SetCellValue("Sheet1", "B6", "Count")
+		f.SetCellValue("Sheet1", "A7", "X")
+		f.SetCellValue("Sheet1", "B7", "5")
+	})
+
+	config := models.DefaultConfig()
+	config.MaxEmptyRows = 5
+	config.TableSeparatorRows = 2
+
+	wb, err := NewWorkbookReaderWithConfig(config).ReadFile(path)
+	if err != nil {
+		t.Fatalf("ReadFile() error = %v", err)
+	}
+
+	tables := wb.Sheets[0].Tables
+	if len(tables) != 2 {
+		t.Fatalf("Expected 2 tables, got %d", len(tables))
+	}
+	if tables[1].Headers[0] != "Code" {
+		t.Errorf("Second table headers = %v, want Code first", tables[1].Headers)
+	}
+}
+
 // =============================================================================
 // ReadSheet Tests
 // =============================================================================
@@ -660,6 +733,47 @@ func TestWorkbookReader_ReadFile_InvalidFile(t *testing.T) {
 // ReadFileParallel Tests
 // =============================================================================
 
+func TestWorkbookReader_ReadSheet_SampleMultiple(t *testing.T) {
+	// Regression: the 2-column table used to pick up the third column of the table below
+	sheet, err := NewWorkbookReader().ReadSheet("../../testdata/sample.xlsx", "Multiple")
+	if err != nil {
+		t.Fatalf("ReadSheet() error = %v", err)
+	}
+
+	if len(sheet.Tables) != 2 {
+		t.Fatalf("len(Tables) = %d, want 2", len(sheet.Tables))
+	}
+	for i, wantCols := range []int{2, 3} {
+		if got := len(sheet.Tables[i].Headers); got != wantCols {
+			t.Errorf("Tables[%d] has %d columns %v, want %d", i, got, sheet.Tables[i].Headers, wantCols)
+		}
+	}
+}
+
+func TestWorkbookReader_SparseTrailingColumnAcrossGap(t *testing.T) {
+	// The block after the gap leaves the optional Notes column blank; it is
+	// still the same table
+	path := createWorkbookTestFile(t, func(f *excelize.File) {
+		f.SetSheetRow("Sheet1", "A1", &[]interface{}{"ID", "Name", "Notes"})
+		f.SetSheetRow("Sheet1", "A2", &[]interface{}{1, "a", "first"})
+		f.SetSheetRow("Sheet1", "A3", &[]interface{}{2, "b", "second"})
+		f.SetSheetRow("Sheet1", "A5", &[]interface{}{3, "c"})
+		f.SetSheetRow("Sheet1", "A6", &[]interface{}{4, "d"})
+	})
+
+	wb, err := NewWorkbookReader().ReadFile(path)
+	if err != nil {
+		t.Fatalf("ReadFile() error = %v", err)
+	}
+	tables := wb.Sheets[0].Tables
+	if len(tables) != 1 {
+		t.Fatalf("len(Tables) = %d, want 1", len(tables))
+	}
+	if got := strings.Join(tables[0].Headers, ","); got != "ID,Name,Notes" || tables[0].RowCount() != 4 {
+		t.Errorf("table = %s with %d rows, want ID,Name,Notes with 4 rows", got, tables[0].RowCount())
+	}
+}
+
 func TestWorkbookReader_ReadFileParallel_MultipleSheets(t *testing.T) {
 	path := createWorkbookTestFile(t, func(f *excelize.File) {
 		// Sheet 1
@@ -922,3 +1036,1003 @@ func TestWorkbookReader_ReadFileParallel_WithCustomConfig(t *testing.T) {
 		t.Errorf("len(wb.Sheets) = %d, want 2", len(wb.Sheets))
 	}
 }
+
+// =============================================================================
+// Progress Tests
+// =============================================================================
+
+func createProgressTestFile(t *testing.T) string {
+	t.Helper()
+	return createWorkbookTestFile(t, func(f *excelize.File) {
+		for i, name := range []string{"Sheet1", "Sheet2", "Sheet3"} {
+			if i > 0 {
+				f.NewSheet(name)
+			}
+			f.SetCellValue(name, "A1", "ID")
+			f.SetCellValue(name, "B1", "Value")
+			f.SetCellValue(name, "A2", "1")
+			f.SetCellValue(name, "B2", "100")
+		}
+	})
+}
+
+func TestWorkbookReader_Progress(t *testing.T) {
+	path := createProgressTestFile(t)
+
+	for _, parallel := range []bool{false, true} {
+		var sheets []string
+		var done []int
+		wr := NewWorkbookReader()
+		wr.SetProgress(func(sheetName string, d, total int) {
+			if total != 3 {
+				t.Errorf("total = %d, want 3", total)
+			}
+			sheets = append(sheets, sheetName)
+			done = append(done, d)
+		})
+
+		var err error
+		if parallel {
+			_, err = wr.ReadFileParallel(path)
+		} else {
+			_, err = wr.ReadFile(path)
+		}
+		if err != nil {
+			t.Fatalf("read (parallel=%v) error = %v", parallel, err)
+		}
+
+		if len(sheets) != 3 {
+			t.Fatalf("parallel=%v: callback called %d times, want 3", parallel, len(sheets))
+		}
+		for i, d := range done {
+			if d != i+1 {
+				t.Errorf("parallel=%v: done[%d] = %d, want %d", parallel, i, d, i+1)
+			}
+		}
+	}
+}
+
+func TestWorkbookReader_Progress_SeparateReads(t *testing.T) {
+	path := createProgressTestFile(t)
+
+	last := 0
+	wr := NewWorkbookReader()
+	wr.SetProgress(func(sheetName string, done, total int) {
+		last = done
+	})
+
+	for i := 0; i < 2; i++ {
+		if _, err := wr.ReadFile(path); err != nil {
+			t.Fatalf("ReadFile() error = %v", err)
+		}
+		if last != 3 {
+			t.Errorf("read %d: last done = %d, want 3", i, last)
+		}
+	}
+}
+
+func TestWorkbookReader_Progress_ConcurrentReads(t *testing.T) {
+	path := createProgressTestFile(t)
+
+	// Each read counts its own sheets, so done never goes past total
+	wr := NewWorkbookReader()
+	wr.SetProgress(func(sheetName string, done, total int) {
+		if done > total {
+			t.Errorf("done = %d, want at most %d", done, total)
+		}
+	})
+
+	var wg sync.WaitGroup
+	for i := 0; i < 4; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			if _, err := wr.ReadFileParallel(path); err != nil {
+				t.Errorf("ReadFileParallel() error = %v", err)
+			}
+		}()
+	}
+	wg.Wait()
+}
+
+// =============================================================================
+// Hidden Rows/Columns Tests
+// =============================================================================
+
+func TestWorkbookReader_ReadFile_SkipHidden(t *testing.T) {
+	path := createWorkbookTestFile(t, func(f *excelize.File) {
+		f.SetCellValue("Sheet1", "A1", "Name")
+		f.SetCellValue("Sheet1", "B1", "Score")
+		f.SetCellValue("Sheet1", "A2", "Alice")
+		f.SetCellValue("Sheet1", "B2", 90)
+		f.SetCellValue("Sheet1", "A3", "Helper")
+		f.SetCellValue("Sheet1", "B3", 0)
+		f.SetCellValue("Sheet1", "A4", "Bob")
+		f.SetCellValue("Sheet1", "B4", 80)
+		f.SetRowVisible("Sheet1", 3, false)
+	})
+
+	hasHelper := func(table models.Table) bool {
+		for _, row := range table.Rows {
+			if cell, ok := row.Get("Name"); ok && cell.RawValue == "Helper" {
+				return true
+			}
+		}
+		return false
+	}
+
+	for _, skip := range []bool{false, true} {
+		config := models.DefaultConfig()
+		config.SkipHidden = skip
+
+		wb, err := NewWorkbookReaderWithConfig(config).ReadFile(path)
+		if err != nil {
+			t.Fatalf("ReadFile() error = %v", err)
+		}
+		if len(wb.Sheets[0].Tables) != 1 {
+			t.Fatalf("SkipHidden=%v: expected 1 table, got %d", skip, len(wb.Sheets[0].Tables))
+		}
+
+		table := wb.Sheets[0].Tables[0]
+		if got := hasHelper(table); got == skip {
+			t.Errorf("SkipHidden=%v: hidden row present = %v", skip, got)
+		}
+		wantRows := 3
+		if skip {
+			wantRows = 2
+		}
+		if table.RowCount() != wantRows {
+			t.Errorf("SkipHidden=%v: RowCount() = %d, want %d", skip, table.RowCount(), wantRows)
+		}
+	}
+}
+
+// =============================================================================
+// Sheet Selection Tests
+// =============================================================================
+
+func TestWorkbookReader_SetSheets(t *testing.T) {
+	path := createWorkbookTestFile(t, func(f *excelize.File) {
+		for i, name := range []string{"Sheet1", "Sales", "Notes", "Inventory"} {
+			if i == 0 {
+				f.SetSheetName("Sheet1", name)
+			} else {
+				f.NewSheet(name)
+			}
+			f.SetCellValue(name, "A1", "ID")
+			f.SetCellValue(name, "B1", "Value")
+			f.SetCellValue(name, "A2", "1")
+			f.SetCellValue(name, "B2", "100")
+		}
+	})
+
+	for _, parallel := range []bool{false, true} {
+		wr := NewWorkbookReader()
+		// Requested out of workbook order
+		wr.SetSheets("Inventory", "Sales")
+
+		var wb *models.Workbook
+		var err error
+		if parallel {
+			wb, err = wr.ReadFileParallel(path)
+		} else {
+			wb, err = wr.ReadFile(path)
+		}
+		if err != nil {
+			t.Fatalf("parallel=%v: error = %v", parallel, err)
+		}
+
+		if len(wb.Sheets) != 2 {
+			t.Fatalf("parallel=%v: len(wb.Sheets) = %d, want 2", parallel, len(wb.Sheets))
+		}
+		if wb.Sheets[0].Name != "Sales" || wb.Sheets[1].Name != "Inventory" {
+			t.Errorf("parallel=%v: sheets = %q, %q, want Sales, Inventory", parallel, wb.Sheets[0].Name, wb.Sheets[1].Name)
+		}
+		// Indices refer to the position in the workbook
+		if wb.Sheets[0].Index != 1 || wb.Sheets[1].Index != 3 {
+			t.Errorf("parallel=%v: indices = %d, %d, want 1, 3", parallel, wb.Sheets[0].Index, wb.Sheets[1].Index)
+		}
+	}
+}
+
+func TestWorkbookReader_SetSheets_NotFound(t *testing.T) {
+	path := createWorkbookTestFile(t, func(f *excelize.File) {
+		f.SetCellValue("Sheet1", "A1", "ID")
+	})
+
+	wr := NewWorkbookReader()
+	wr.SetSheets("Sheet1", "Missing")
+
+	if _, err := wr.ReadFile(path); err == nil {
+		t.Error("Expected error for missing sheet")
+	}
+}
+
+// =============================================================================
+// Hierarchical Header Tests
+// =============================================================================
+
+func createHierarchicalHeaderFile(t *testing.T) string {
+	return createWorkbookTestFile(t, func(f *excelize.File) {
+		f.SetCellValue("Sheet1", "A1", "ID")
+		f.MergeCell("Sheet1", "A1", "A2")
+		f.SetCellValue("Sheet1", "B1", "Group A")
+		f.MergeCell("Sheet1", "B1", "C1")
+		f.SetCellValue("Sheet1", "D1", "Group B")
+		f.MergeCell("Sheet1", "D1", "E1")
+		f.SetCellValue("Sheet1", "B2", "Sub1")
+		f.SetCellValue("Sheet1", "C2", "Sub2")
+		f.SetCellValue("Sheet1", "D2", "Sub1")
+		f.SetCellValue("Sheet1", "E2", "Sub2")
+		for row := 3; row <= 5; row++ {
+			f.SetCellValue("Sheet1", fmt.Sprintf("A%d", row), row-2)
+			for _, col := range []string{"B", "C", "D", "E"} {
+				f.SetCellValue("Sheet1", fmt.Sprintf("%s%d", col, row), row*10)
+			}
+		}
+	})
+}
+
+func TestWorkbookReader_FlattenHierarchicalHeaders(t *testing.T) {
+	path := createHierarchicalHeaderFile(t)
+
+	config := models.DefaultConfig()
+	config.FlattenHierarchicalHeaders = true
+
+	wb, err := NewWorkbookReaderWithConfig(config).ReadFile(path)
+	if err != nil {
+		t.Fatalf("ReadFile() error = %v", err)
+	}
+	if len(wb.Sheets[0].Tables) != 1 {
+		t.Fatalf("Expected 1 table, got %d", len(wb.Sheets[0].Tables))
+	}
+
+	table := wb.Sheets[0].Tables[0]
+	want := []string{"ID", "Group A > Sub1", "Group A > Sub2", "Group B > Sub1", "Group B > Sub2"}
+	if len(table.Headers) != len(want) {
+		t.Fatalf("Headers = %v, want %v", table.Headers, want)
+	}
+	for i, h := range want {
+		if table.Headers[i] != h {
+			t.Errorf("Headers[%d] = %q, want %q", i, table.Headers[i], h)
+		}
+	}
+
+	// The sub-header row must not appear as data
+	if table.RowCount() != 3 {
+		t.Errorf("RowCount() = %d, want 3", table.RowCount())
+	}
+	if table.HeaderRow != 1 {
+		t.Errorf("HeaderRow = %d, want 1", table.HeaderRow)
+	}
+	if cell, ok := table.Rows[0].Get("Group B > Sub2"); !ok || cell.RawValue != "30" {
+		t.Errorf("Rows[0][Group B > Sub2] = %q, want 30", cell.RawValue)
+	}
+}
+
+func TestWorkbookReader_CoalesceHeaderWords(t *testing.T) {
+	path := createWorkbookTestFile(t, func(f *excelize.File) {
+		f.SetCellValue("Sheet1", "A1", "ID")
+		f.SetCellValue("Sheet1", "B1", "First")
+		f.SetCellValue("Sheet1", "B2", "Name")
+		f.SetCellValue("Sheet1", "C1", "Last")
+		f.SetCellValue("Sheet1", "C2", "Name")
+		for i, name := range []string{"Alice", "Bob", "Carol"} {
+			f.SetCellValue("Sheet1", fmt.Sprintf("A%d", i+3), i+1)
+			f.SetCellValue("Sheet1", fmt.Sprintf("B%d", i+3), name)
+			f.SetCellValue("Sheet1", fmt.Sprintf("C%d", i+3), "Smith")
+		}
+	})
+
+	config := models.DefaultConfig()
+	config.CoalesceHeaderWords = true
+
+	wb, err := NewWorkbookReaderWithConfig(config).ReadFile(path)
+	if err != nil {
+		t.Fatalf("ReadFile() error = %v", err)
+	}
+
+	table := wb.Sheets[0].Tables[0]
+	want := []string{"ID", "First Name", "Last Name"}
+	if len(table.Headers) != len(want) {
+		t.Fatalf("Headers = %v, want %v", table.Headers, want)
+	}
+	for i, h := range want {
+		if table.Headers[i] != h {
+			t.Errorf("Headers[%d] = %q, want %q", i, table.Headers[i], h)
+		}
+	}
+	if table.RowCount() != 3 {
+		t.Errorf("RowCount() = %d, want 3", table.RowCount())
+	}
+	if first, _ := table.Rows[0].Get("First Name"); first.AsString() != "Alice" {
+		t.Errorf("First Name = %q, want Alice", first.AsString())
+	}
+}
+
+func TestWorkbookReader_FlattenHierarchicalHeaders_CustomSeparator(t *testing.T) {
+	path := createHierarchicalHeaderFile(t)
+
+	config := models.DefaultConfig()
+	config.FlattenHierarchicalHeaders = true
+	config.HeaderSeparator = "/"
+
+	wb, err := NewWorkbookReaderWithConfig(config).ReadFile(path)
+	if err != nil {
+		t.Fatalf("ReadFile() error = %v", err)
+	}
+
+	table := wb.Sheets[0].Tables[0]
+	if len(table.Headers) < 2 || table.Headers[1] != "Group A/Sub1" {
+		t.Errorf("Headers = %v, want Headers[1] = Group A/Sub1", table.Headers)
+	}
+}
+
+// =============================================================================
+// Sheet Visibility Tests
+// =============================================================================
+
+func createHiddenSheetsFile(t *testing.T) string {
+	return createWorkbookTestFile(t, func(f *excelize.File) {
+		for i, name := range []string{"Sheet1", "Hidden", "VeryHidden"} {
+			if i > 0 {
+				f.NewSheet(name)
+			}
+			f.SetCellValue(name, "A1", "ID")
+			f.SetCellValue(name, "B1", "Value")
+			f.SetCellValue(name, "A2", "1")
+			f.SetCellValue(name, "B2", "100")
+		}
+		f.SetSheetVisible("Hidden", false)
+		f.SetSheetVisible("VeryHidden", false, true)
+	})
+}
+
+func TestWorkbookReader_SheetVisibility(t *testing.T) {
+	path := createHiddenSheetsFile(t)
+
+	for _, parallel := range []bool{false, true} {
+		wr := NewWorkbookReader()
+		var wb *models.Workbook
+		var err error
+		if parallel {
+			wb, err = wr.ReadFileParallel(path)
+		} else {
+			wb, err = wr.ReadFile(path)
+		}
+		if err != nil {
+			t.Fatalf("parallel=%v: error = %v", parallel, err)
+		}
+		if len(wb.Sheets) != 3 {
+			t.Fatalf("parallel=%v: len(wb.Sheets) = %d, want 3", parallel, len(wb.Sheets))
+		}
+
+		want := []models.SheetVisibility{models.VisibilityVisible, models.VisibilityHidden, models.VisibilityVeryHidden}
+		for i, v := range want {
+			if wb.Sheets[i].Visibility != v {
+				t.Errorf("parallel=%v: %s Visibility = %v, want %v", parallel, wb.Sheets[i].Name, wb.Sheets[i].Visibility, v)
+			}
+		}
+	}
+}
+
+func TestWorkbookReader_SkipHiddenSheets(t *testing.T) {
+	path := createHiddenSheetsFile(t)
+
+	config := models.DefaultConfig()
+	config.SkipHiddenSheets = true
+
+	wb, err := NewWorkbookReaderWithConfig(config).ReadFile(path)
+	if err != nil {
+		t.Fatalf("ReadFile() error = %v", err)
+	}
+	if len(wb.Sheets) != 1 || wb.Sheets[0].Name != "Sheet1" {
+		t.Errorf("Expected only Sheet1, got %d sheets", len(wb.Sheets))
+	}
+}
+
+func TestWorkbookReader_ReadSheet_Visibility(t *testing.T) {
+	path := createHiddenSheetsFile(t)
+
+	sheet, err := NewWorkbookReader().ReadSheet(path, "Hidden")
+	if err != nil {
+		t.Fatalf("ReadSheet() error = %v", err)
+	}
+	if sheet.Visibility != models.VisibilityHidden {
+		t.Errorf("Visibility = %v, want hidden", sheet.Visibility)
+	}
+}
+
+// =============================================================================
+// Transposed Table Tests
+// =============================================================================
+
+func createTransposedFile(t *testing.T) string {
+	return createWorkbookTestFile(t, func(f *excelize.File) {
+		f.SetCellValue("Sheet1", "A1", "Name")
+		f.SetCellValue("Sheet1", "B1", "Alice")
+		f.SetCellValue("Sheet1", "C1", "Bob")
+		f.SetCellValue("Sheet1", "A2", "Age")
+		f.SetCellValue("Sheet1", "B2", 30)
+		f.SetCellValue("Sheet1", "C2", 25)
+		f.SetCellValue("Sheet1", "A3", "City")
+		f.SetCellValue("Sheet1", "B3", "NYC")
+		f.SetCellValue("Sheet1", "C3", "LA")
+	})
+}
+
+func TestWorkbookReader_DetectTransposed(t *testing.T) {
+	path := createTransposedFile(t)
+
+	config := models.DefaultConfig()
+	config.DetectTransposed = true
+
+	wb, err := NewWorkbookReaderWithConfig(config).ReadFile(path)
+	if err != nil {
+		t.Fatalf("ReadFile() error = %v", err)
+	}
+	if len(wb.Sheets[0].Tables) != 1 {
+		t.Fatalf("Expected 1 table, got %d", len(wb.Sheets[0].Tables))
+	}
+
+	table := wb.Sheets[0].Tables[0]
+	if !table.Transposed {
+		t.Error("Expected table to be marked as transposed")
+	}
+
+	wantHeaders := []string{"Name", "Age", "City"}
+	if len(table.Headers) != len(wantHeaders) {
+		t.Fatalf("Headers = %v, want %v", table.Headers, wantHeaders)
+	}
+	for i, h := range wantHeaders {
+		if table.Headers[i] != h {
+			t.Errorf("Headers[%d] = %q, want %q", i, table.Headers[i], h)
+		}
+	}
+
+	if table.RowCount() != 2 {
+		t.Fatalf("RowCount() = %d, want 2", table.RowCount())
+	}
+	if cell, _ := table.Rows[1].Get("Name"); cell.RawValue != "Bob" {
+		t.Errorf("Rows[1][Name] = %q, want Bob", cell.RawValue)
+	}
+	if cell, _ := table.Rows[0].Get("Age"); cell.Type != models.CellTypeNumber || cell.RawValue != "30" {
+		t.Errorf("Rows[0][Age] = %q (type %v), want number 30", cell.RawValue, cell.Type)
+	}
+	// Cells keep their sheet coordinates (C3 holds Bob's city)
+	if cell, _ := table.Rows[1].Get("City"); cell.Row != 2 || cell.Col != 2 {
+		t.Errorf("Rows[1][City] at (%d, %d), want (2, 2)", cell.Row, cell.Col)
+	}
+}
+
+func TestWorkbookReader_DetectTransposed_Disabled(t *testing.T) {
+	path := createTransposedFile(t)
+
+	wb, err := NewWorkbookReader().ReadFile(path)
+	if err != nil {
+		t.Fatalf("ReadFile() error = %v", err)
+	}
+
+	table := wb.Sheets[0].Tables[0]
+	if table.Transposed || table.Headers[0] != "Name" || table.Headers[1] != "Alice" {
+		t.Errorf("Expected normal orientation, got headers %v", table.Headers)
+	}
+}
+
+func TestWorkbookReader_DetectTransposed_NormalTable(t *testing.T) {
+	path := createWorkbookTestFile(t, func(f *excelize.File) {
+		f.SetCellValue("Sheet1", "A1", "ID")
+		f.SetCellValue("Sheet1", "B1", "Name")
+		f.SetCellValue("Sheet1", "C1", "Age")
+		f.SetCellValue("Sheet1", "A2", 1)
+		f.SetCellValue("Sheet1", "B2", "Alice")
+		f.SetCellValue("Sheet1", "C2", 30)
+		f.SetCellValue("Sheet1", "A3", 2)
+		f.SetCellValue("Sheet1", "B3", "Bob")
+		f.SetCellValue("Sheet1", "C3", 25)
+	})
+
+	config := models.DefaultConfig()
+	config.DetectTransposed = true
+
+	wb, err := NewWorkbookReaderWithConfig(config).ReadFile(path)
+	if err != nil {
+		t.Fatalf("ReadFile() error = %v", err)
+	}
+
+	table := wb.Sheets[0].Tables[0]
+	if table.Transposed {
+		t.Errorf("Normal table should not be transposed, got headers %v", table.Headers)
+	}
+}
+
+func TestWorkbookReader_CaptureTitles_Merged(t *testing.T) {
+	path := createWorkbookTestFile(t, func(f *excelize.File) {
+		f.SetCellValue("Sheet1", "A1", "Q1 Sales")
+		f.MergeCell("Sheet1", "A1", "C1")
+		f.SetSheetRow("Sheet1", "A3", &[]interface{}{"Region", "Rep", "Total"})
+		f.SetSheetRow("Sheet1", "A4", &[]interface{}{"North", "Ann", 120})
+		f.SetSheetRow("Sheet1", "A5", &[]interface{}{"South", "Raj", 95})
+	})
+
+	config := models.DefaultConfig()
+	config.CaptureTitles = true
+	wb, err := NewWorkbookReaderWithConfig(config).ReadFile(path)
+	if err != nil {
+		t.Fatalf("ReadFile() error = %v", err)
+	}
+	tables := wb.Sheets[0].Tables
+	if len(tables) != 1 {
+		t.Fatalf("len(Tables) = %d, want 1", len(tables))
+	}
+	if tables[0].Title != "Q1 Sales" {
+		t.Errorf("Title = %q, want Q1 Sales", tables[0].Title)
+	}
+	if tables[0].Headers[0] != "Region" {
+		t.Errorf("Headers = %v, want the header row below the title", tables[0].Headers)
+	}
+}
+
+func TestWorkbookReader_TableNamer(t *testing.T) {
+	path := createWorkbookTestFile(t, func(f *excelize.File) {
+		f.SetSheetRow("Sheet1", "A1", &[]interface{}{"Invoice", "Amount"})
+		f.SetSheetRow("Sheet1", "A2", &[]interface{}{"INV-1", 10})
+		f.SetSheetRow("Sheet1", "A3", &[]interface{}{"INV-2", 20})
+	})
+
+	config := models.DefaultConfig()
+	config.TableNamer = func(sheet string, index int, headers []string) string {
+		return fmt.Sprintf("%s/%s#%d", sheet, headers[0], index)
+	}
+	wb, err := NewWorkbookReaderWithConfig(config).ReadFile(path)
+	if err != nil {
+		t.Fatalf("ReadFile() error = %v", err)
+	}
+	if name := wb.Sheets[0].Tables[0].Name; name != "Sheet1/Invoice#1" {
+		t.Errorf("Name = %q, want Sheet1/Invoice#1", name)
+	}
+
+	// An empty name falls back to the default
+	config.TableNamer = func(string, int, []string) string { return "" }
+	wb, err = NewWorkbookReaderWithConfig(config).ReadFile(path)
+	if err != nil {
+		t.Fatalf("ReadFile() error = %v", err)
+	}
+	if name := wb.Sheets[0].Tables[0].Name; name != "Sheet1_Table1" {
+		t.Errorf("Name = %q, want Sheet1_Table1", name)
+	}
+}
+
+func TestWorkbookReader_MaxScanColumns(t *testing.T) {
+	path := createWorkbookTestFile(t, func(f *excelize.File) {
+		f.SetSheetRow("Sheet1", "A1", &[]interface{}{"Invoice", "Amount"})
+		f.SetSheetRow("Sheet1", "A2", &[]interface{}{"INV-1", 10})
+		f.SetSheetRow("Sheet1", "A3", &[]interface{}{"INV-2", 20})
+		f.SetCellValue("Sheet1", "ZZ1", "stray")
+	})
+
+	config := models.DefaultConfig()
+	config.MaxScanColumns = 26
+	wb, err := NewWorkbookReaderWithConfig(config).ReadFile(path)
+	if err != nil {
+		t.Fatalf("ReadFile() error = %v", err)
+	}
+	table := wb.Sheets[0].Tables[0]
+	if len(table.Headers) != 2 || table.EndCol != 1 {
+		t.Errorf("Headers = %v (EndCol %d), want [Invoice Amount] (EndCol 1)", table.Headers, table.EndCol)
+	}
+}
+
+func TestWorkbookReader_KeepTrailingEmptyColumns(t *testing.T) {
+	path := createWorkbookTestFile(t, func(f *excelize.File) {
+		f.SetSheetRow("Sheet1", "A1", &[]interface{}{"Name", "Score"})
+		for row := 2; row <= 15; row++ {
+			values := []interface{}{fmt.Sprintf("P%d", row), row}
+			if row >= 13 {
+				values = append(values, "late note")
+			}
+			f.SetSheetRow("Sheet1", fmt.Sprintf("A%d", row), &values)
+		}
+	})
+
+	wb, err := NewWorkbookReader().ReadFile(path)
+	if err != nil {
+		t.Fatalf("ReadFile() error = %v", err)
+	}
+	if headers := wb.Sheets[0].Tables[0].Headers; len(headers) != 2 {
+		t.Fatalf("default Headers = %v, want the 2 header-row columns", headers)
+	}
+
+	config := models.DefaultConfig()
+	config.KeepTrailingEmptyColumns = true
+	wb, err = NewWorkbookReaderWithConfig(config).ReadFile(path)
+	if err != nil {
+		t.Fatalf("ReadFile() error = %v", err)
+	}
+	table := wb.Sheets[0].Tables[0]
+	if got := strings.Join(table.Headers, ","); got != "Name,Score,Column_3" {
+		t.Fatalf("Headers = %q, want %q", got, "Name,Score,Column_3")
+	}
+	if table.RowCount() != 14 {
+		t.Errorf("RowCount() = %d, want 14", table.RowCount())
+	}
+	last := table.Rows[len(table.Rows)-1].Values["Column_3"]
+	if last.AsString() != "late note" {
+		t.Errorf("last Column_3 = %q, want %q", last.AsString(), "late note")
+	}
+	if first := table.Rows[0].Values["Column_3"]; !first.IsEmpty() {
+		t.Errorf("first Column_3 = %q, want empty", first.AsString())
+	}
+}
+
+func TestWorkbookReader_BoolValues(t *testing.T) {
+	path := createWorkbookTestFile(t, func(f *excelize.File) {
+		f.SetSheetRow("Sheet1", "A1", &[]interface{}{"Name", "Subscribed", "Status"})
+		f.SetSheetRow("Sheet1", "A2", &[]interface{}{"Alice", "Yes", "Active"})
+		f.SetSheetRow("Sheet1", "A3", &[]interface{}{"Bob", "No", "Inactive"})
+		f.SetSheetRow("Sheet1", "A4", &[]interface{}{"Carol", "yes", "Active"})
+	})
+
+	config := models.DefaultConfig()
+	config.BoolTrueValues = []string{"Yes", "Active"}
+	config.BoolFalseValues = []string{"No", "Inactive"}
+	wb, err := NewWorkbookReaderWithConfig(config).ReadFile(path)
+	if err != nil {
+		t.Fatalf("ReadFile() error = %v", err)
+	}
+	table := wb.Sheets[0].Tables[0]
+
+	want := []bool{true, false, true}
+	for _, column := range []string{"Subscribed", "Status"} {
+		for i, cell := range table.ColumnValues(column) {
+			if cell.Type != models.CellTypeBool || cell.Value != want[i] {
+				t.Errorf("%s row %d = %v (%v), want bool %v", column, i, cell.Value, cell.Type, want[i])
+			}
+		}
+	}
+	for _, stats := range table.AnalyzeColumns() {
+		if stats.Name == "Subscribed" && stats.InferredType != models.CellTypeBool {
+			t.Errorf("Subscribed InferredType = %v, want %v", stats.InferredType, models.CellTypeBool)
+		}
+	}
+
+	// Without configured values the words stay strings
+	wb, err = NewWorkbookReader().ReadFile(path)
+	if err != nil {
+		t.Fatalf("ReadFile() error = %v", err)
+	}
+	if cell := wb.Sheets[0].Tables[0].ColumnValues("Subscribed")[0]; cell.Type != models.CellTypeString {
+		t.Errorf("default Subscribed Type = %v, want %v", cell.Type, models.CellTypeString)
+	}
+}
+
+func TestWorkbookReader_PreferNativeTables(t *testing.T) {
+	path := createWorkbookTestFile(t, func(f *excelize.File) {
+		// Notes sits right next to the Excel Table, so detection alone reads A1:C4
+		f.SetSheetRow("Sheet1", "A1", &[]interface{}{"Product", "Qty", "Notes"})
+		f.SetSheetRow("Sheet1", "A2", &[]interface{}{"Apple", 10, "fresh"})
+		f.SetSheetRow("Sheet1", "A3", &[]interface{}{"Pear", 5, "ripe"})
+		f.SetSheetRow("Sheet1", "A4", &[]interface{}{"Plum", 7, "sour"})
+		if err := f.AddTable("Sheet1", &excelize.Table{Range: "A1:B4", Name: "Sales"}); err != nil {
+			t.Fatalf("AddTable() error = %v", err)
+		}
+	})
+
+	config := models.DefaultConfig()
+	config.PreferNativeTables = true
+	wb, err := NewWorkbookReaderWithConfig(config).ReadFile(path)
+	if err != nil {
+		t.Fatalf("ReadFile() error = %v", err)
+	}
+
+	sheet := wb.Sheets[0]
+	if len(sheet.ExcelTables) != 1 || sheet.ExcelTables[0] != (models.ExcelTableRef{Name: "Sales", Range: "A1:B4"}) {
+		t.Fatalf("ExcelTables = %+v, want [{Sales A1:B4}]", sheet.ExcelTables)
+	}
+	if len(sheet.Tables) != 1 {
+		t.Fatalf("len(Tables) = %d, want 1", len(sheet.Tables))
+	}
+	table := sheet.Tables[0]
+	if table.Name != "Sales" {
+		t.Errorf("Name = %q, want Sales", table.Name)
+	}
+	if table.StartRow != 0 || table.EndRow != 3 || table.StartCol != 0 || table.EndCol != 1 {
+		t.Errorf("range = rows %d-%d cols %d-%d, want rows 0-3 cols 0-1", table.StartRow, table.EndRow, table.StartCol, table.EndCol)
+	}
+	if len(table.Headers) != 2 || table.RowCount() != 3 {
+		t.Errorf("Headers = %v, RowCount() = %d, want [Product Qty] and 3 rows", table.Headers, table.RowCount())
+	}
+
+	// Without the option the ranges are still reported but detection is unchanged
+	wb, err = NewWorkbookReader().ReadFile(path)
+	if err != nil {
+		t.Fatalf("ReadFile() error = %v", err)
+	}
+	if got := wb.Sheets[0]; len(got.ExcelTables) != 1 || got.Tables[0].EndCol != 2 {
+		t.Errorf("default read: ExcelTables = %+v, EndCol = %d, want 1 ref and EndCol 2", got.ExcelTables, got.Tables[0].EndCol)
+	}
+}
+
+func TestWorkbookReader_AutoFilterRange(t *testing.T) {
+	path := createWorkbookTestFile(t, func(f *excelize.File) {
+		f.SetSheetRow("Sheet1", "A1", &[]interface{}{"Name", "Score"})
+		f.SetSheetRow("Sheet1", "A2", &[]interface{}{"Alice", 90})
+		f.SetSheetRow("Sheet1", "A3", &[]interface{}{"Bob", 80})
+		if err := f.AutoFilter("Sheet1", "A1:B3", nil); err != nil {
+			t.Fatalf("AutoFilter() error = %v", err)
+		}
+	})
+
+	wb, err := NewWorkbookReader().ReadFile(path)
+	if err != nil {
+		t.Fatalf("ReadFile() error = %v", err)
+	}
+
+	want := models.ExcelTableRef{Range: "A1:B3", AutoFilter: true}
+	if refs := wb.Sheets[0].ExcelTables; len(refs) != 1 || refs[0] != want {
+		t.Errorf("ExcelTables = %+v, want [%+v]", refs, want)
+	}
+}
+
+func TestWorkbookReader_FrozenPanesAndPrintArea(t *testing.T) {
+	path := createWorkbookTestFile(t, func(f *excelize.File) {
+		f.SetSheetRow("Sheet1", "A1", &[]interface{}{"Name", "Score"})
+		f.SetSheetRow("Sheet1", "A2", &[]interface{}{"Alice", 90})
+		f.SetSheetRow("Sheet1", "A3", &[]interface{}{"Bob", 80})
+		if err := f.SetPanes("Sheet1", &excelize.Panes{Freeze: true, YSplit: 1, TopLeftCell: "A2", ActivePane: "bottomLeft"}); err != nil {
+			t.Fatalf("SetPanes() error = %v", err)
+		}
+		if err := f.SetDefinedName(&excelize.DefinedName{Name: "_xlnm.Print_Area", RefersTo: "Sheet1!$A$1:$B$3", Scope: "Sheet1"}); err != nil {
+			t.Fatalf("SetDefinedName() error = %v", err)
+		}
+		f.NewSheet("Plain")
+		f.SetSheetRow("Plain", "A1", &[]interface{}{"ID"})
+		f.SetSheetRow("Plain", "A2", &[]interface{}{1})
+	})
+
+	wb, err := NewWorkbookReader().ReadFile(path)
+	if err != nil {
+		t.Fatalf("ReadFile() error = %v", err)
+	}
+
+	sheet := wb.Sheets[0]
+	if sheet.FrozenRows != 1 || sheet.FrozenCols != 0 {
+		t.Errorf("frozen = %d rows, %d cols, want 1 row, 0 cols", sheet.FrozenRows, sheet.FrozenCols)
+	}
+	if sheet.PrintArea != "A1:B3" {
+		t.Errorf("PrintArea = %q, want %q", sheet.PrintArea, "A1:B3")
+	}
+
+	plain := wb.Sheets[1]
+	if plain.FrozenRows != 0 || plain.FrozenCols != 0 || plain.PrintArea != "" {
+		t.Errorf("Plain sheet = %d rows, %d cols, print area %q, want none", plain.FrozenRows, plain.FrozenCols, plain.PrintArea)
+	}
+}
+
+func TestWorkbookReader_ReadFile_Properties(t *testing.T) {
+	calcMode := "manual"
+	path := createWorkbookTestFile(t, func(f *excelize.File) {
+		f.SetSheetRow("Sheet1", "A1", &[]interface{}{"Name", "Score"})
+		f.SetSheetRow("Sheet1", "A2", &[]interface{}{"Alice", 90})
+		f.SetDocProps(&excelize.DocProperties{
+			Creator:        "Ana Analyst",
+			LastModifiedBy: "Rui Reviewer",
+			Title:          "Quarterly Scores",
+			Created:        "2024-01-15T09:30:00Z",
+			Modified:       "2024-02-01T17:00:00Z",
+		})
+		f.SetAppProps(&excelize.AppProperties{Company: "Acme Corp"})
+		f.SetCalcProps(&excelize.CalcPropsOptions{CalcMode: &calcMode})
+	})
+
+	wb, err := NewWorkbookReader().ReadFile(path)
+	if err != nil {
+		t.Fatalf("ReadFile() error = %v", err)
+	}
+
+	props := wb.Properties
+	if props.Creator != "Ana Analyst" || props.LastModifiedBy != "Rui Reviewer" || props.Title != "Quarterly Scores" {
+		t.Errorf("Properties = %+v, want creator, modifier and title set", props)
+	}
+	if props.Company != "Acme Corp" {
+		t.Errorf("Company = %q, want Acme Corp", props.Company)
+	}
+	if props.CalcMode != "manual" {
+		t.Errorf("CalcMode = %q, want manual", props.CalcMode)
+	}
+	if want := time.Date(2024, 1, 15, 9, 30, 0, 0, time.UTC); !props.Created.Equal(want) {
+		t.Errorf("Created = %v, want %v", props.Created, want)
+	}
+	if want := time.Date(2024, 2, 1, 17, 0, 0, 0, time.UTC); !props.Modified.Equal(want) {
+		t.Errorf("Modified = %v, want %v", props.Modified, want)
+	}
+}
+
+func TestWorkbookReader_RemoveRepeatedHeaders(t *testing.T) {
+	path := createWorkbookTestFile(t, func(f *excelize.File) {
+		row := 1
+		for i := 0; i < 100; i++ {
+			if i%50 == 0 {
+				f.SetSheetRow("Sheet1", fmt.Sprintf("A%d", row), &[]interface{}{"ID", "Name", "Amount"})
+				row++
+			}
+			f.SetSheetRow("Sheet1", fmt.Sprintf("A%d", row), &[]interface{}{i + 1, fmt.Sprintf("Item %d", i+1), float64(i) * 1.5})
+			row++
+		}
+	})
+
+	wb, err := NewWorkbookReader().ReadFile(path)
+	if err != nil {
+		t.Fatalf("ReadFile() error = %v", err)
+	}
+	if got := len(wb.Sheets[0].Tables[0].Rows); got != 101 {
+		t.Fatalf("default Rows = %d, want 101 including the repeated header", got)
+	}
+
+	config := models.DefaultConfig()
+	config.RemoveRepeatedHeaders = true
+	wb, err = NewWorkbookReaderWithConfig(config).ReadFile(path)
+	if err != nil {
+		t.Fatalf("ReadFile() error = %v", err)
+	}
+	tables := wb.Sheets[0].Tables
+	if len(tables) != 1 || len(tables[0].Rows) != 100 {
+		t.Fatalf("Tables = %d, Rows = %d, want 1 table with 100 rows", len(tables), len(tables[0].Rows))
+	}
+	for _, row := range tables[0].Rows {
+		if cell := row.Values["ID"]; cell.Type != models.CellTypeNumber {
+			t.Errorf("row %d ID = %v (%v), want a number", row.Index, cell.Value, cell.Type)
+		}
+	}
+}
+
+func TestWorkbookReader_ForceStringColumns(t *testing.T) {
+	path := createWorkbookTestFile(t, func(f *excelize.File) {
+		f.SetSheetRow("Sheet1", "B2", &[]interface{}{"City", "Zip", "Population"})
+		f.SetSheetRow("Sheet1", "B3", &[]interface{}{"Boston", nil, 650000})
+		f.SetSheetRow("Sheet1", "B4", &[]interface{}{"Hartford", nil, 120000})
+		f.SetSheetRow("Sheet1", "B5", &[]interface{}{"Beverly Hills", nil, 32000})
+		// Untyped cells, as written by some exporters, keep their text in the file
+		for i, zip := range []string{"02108", "06103", "90210"} {
+			f.SetCellDefault("Sheet1", fmt.Sprintf("C%d", i+3), zip)
+		}
+	})
+
+	wb, err := NewWorkbookReader().ReadFile(path)
+	if err != nil {
+		t.Fatalf("ReadFile() error = %v", err)
+	}
+	if cell := wb.Sheets[0].Tables[0].Rows[0].Values["Zip"]; cell.Type != models.CellTypeNumber {
+		t.Fatalf("default Zip type = %v, want number", cell.Type)
+	}
+
+	byName := models.DefaultConfig()
+	byName.ForceStringColumns = []string{"Zip"}
+	byIndex := models.DefaultConfig()
+	byIndex.ForceStringColumnIndexes = []int{2} // column C
+
+	for name, config := range map[string]models.DetectionConfig{"by name": byName, "by index": byIndex} {
+		t.Run(name, func(t *testing.T) {
+			wb, err := NewWorkbookReaderWithConfig(config).ReadFile(path)
+			if err != nil {
+				t.Fatalf("ReadFile() error = %v", err)
+			}
+			table := wb.Sheets[0].Tables[0]
+			for i, want := range []string{"02108", "06103", "90210"} {
+				cell := table.Rows[i].Values["Zip"]
+				if cell.Type != models.CellTypeString || cell.Value != want || table.Rows[i].Cells[1].Value != want {
+					t.Errorf("row %d Zip = %v (%v), want string %q", i, cell.Value, cell.Type, want)
+				}
+			}
+			if cell := table.Rows[0].Values["Population"]; cell.Type != models.CellTypeNumber {
+				t.Errorf("Population type = %v, want number", cell.Type)
+			}
+		})
+	}
+}
+
+func TestWorkbookReader_ForceStringColumns_CustomFormat(t *testing.T) {
+	path := createWorkbookTestFile(t, func(f *excelize.File) {
+		zipFormat := "00000"
+		style, _ := f.NewStyle(&excelize.Style{CustomNumFmt: &zipFormat})
+		f.SetSheetRow("Sheet1", "A1", &[]interface{}{"City", "Zip", "Population"})
+		f.SetSheetRow("Sheet1", "A2", &[]interface{}{"Boston", 2108, 650000})
+		f.SetSheetRow("Sheet1", "A3", &[]interface{}{"Hartford", 6103, 120000})
+		f.SetCellStyle("Sheet1", "B2", "B3", style)
+	})
+
+	config := models.DefaultConfig()
+	config.ForceStringColumns = []string{"Zip"}
+	wb, err := NewWorkbookReaderWithConfig(config).ReadFile(path)
+	if err != nil {
+		t.Fatalf("ReadFile() error = %v", err)
+	}
+	table := wb.Sheets[0].Tables[0]
+	for i, want := range []string{"02108", "06103"} {
+		if cell := table.Rows[i].Values["Zip"]; cell.Type != models.CellTypeString || cell.Value != want {
+			t.Errorf("row %d Zip = %v (%v), want string %q", i, cell.Value, cell.Type, want)
+		}
+	}
+}
+
+func TestWorkbookReader_StripTextMarker(t *testing.T) {
+	path := createWorkbookTestFile(t, func(f *excelize.File) {
+		// Excel stores '007 as the text 007 with the quotePrefix style flag
+		textFormat := "@"
+		marked, _ := f.NewStyle(&excelize.Style{CustomNumFmt: &textFormat})
+		quotePrefix := true
+		f.Styles.CellXfs.Xf[marked].QuotePrefix = &quotePrefix
+
+		f.SetSheetRow("Sheet1", "A1", &[]interface{}{"Agent", "Name", "Missions"})
+		f.SetSheetRow("Sheet1", "A2", &[]interface{}{"007", "Bond", 24})
+		f.SetSheetRow("Sheet1", "A3", &[]interface{}{"'006", "Trevelyan", 12})
+		f.SetSheetRow("Sheet1", "A4", &[]interface{}{"'90s", "Unmarked", 3})
+		f.SetCellStyle("Sheet1", "A2", "A3", marked)
+	})
+
+	wb, err := NewWorkbookReader().ReadFile(path)
+	if err != nil {
+		t.Fatalf("ReadFile() error = %v", err)
+	}
+	rows := wb.Sheets[0].Tables[0].Rows
+	for i, want := range []string{"007", "006"} {
+		if cell := rows[i].Values["Agent"]; cell.Type != models.CellTypeString || cell.Value != want || cell.RawValue != want {
+			t.Errorf("row %d Agent = %v (%v, raw %q), want string %s", i, cell.Value, cell.Type, cell.RawValue, want)
+		}
+	}
+	if cell := rows[2].Values["Agent"]; cell.RawValue != "'90s" {
+		t.Errorf("unmarked Agent = %q, want '90s kept", cell.RawValue)
+	}
+
+	config := models.DefaultConfig()
+	config.StripTextMarker = false
+	wb, err = NewWorkbookReaderWithConfig(config).ReadFile(path)
+	if err != nil {
+		t.Fatalf("ReadFile() error = %v", err)
+	}
+	if cell := wb.Sheets[0].Tables[0].Rows[1].Values["Agent"]; cell.RawValue != "'006" {
+		t.Errorf("RawValue with StripTextMarker off = %q, want '006", cell.RawValue)
+	}
+}
+
+func TestWorkbookReader_IgnoreTables(t *testing.T) {
+	path := createWorkbookTestFile(t, func(f *excelize.File) {
+		// Metadata block from the template, detected as a table of its own
+		f.SetSheetRow("Sheet1", "A1", &[]interface{}{"Template", "Orders v2"})
+		f.SetSheetRow("Sheet1", "A2", &[]interface{}{"Owner", "Finance"})
+		f.SetSheetRow("Sheet1", "A6", &[]interface{}{"Product", "Qty", "Price"})
+		f.SetSheetRow("Sheet1", "A7", &[]interface{}{"Apple", 3, 1.5})
+		f.SetSheetRow("Sheet1", "A8", &[]interface{}{"Pear", 5, 2.25})
+	})
+
+	wb, err := NewWorkbookReader().ReadFile(path)
+	if err != nil {
+		t.Fatalf("ReadFile() error = %v", err)
+	}
+	if got := len(wb.Sheets[0].Tables); got != 2 {
+		t.Fatalf("default tables = %d, want 2", got)
+	}
+
+	byKeyword := models.DefaultConfig()
+	byKeyword.IgnoreTablesWithoutHeaders = []string{"product", "sku"}
+	blankKeyword := models.DefaultConfig()
+	blankKeyword.IgnoreTablesWithoutHeaders = []string{"", "PRODUCT"}
+	byPredicate := models.DefaultConfig()
+	byPredicate.AcceptTable = func(table *models.Table) bool { return table.RowCount() > 1 }
+
+	for name, config := range map[string]models.DetectionConfig{"by keyword": byKeyword, "blank keyword skipped": blankKeyword, "by predicate": byPredicate} {
+		t.Run(name, func(t *testing.T) {
+			wb, err := NewWorkbookReaderWithConfig(config).ReadFile(path)
+			if err != nil {
+				t.Fatalf("ReadFile() error = %v", err)
+			}
+			tables := wb.Sheets[0].Tables
+			if len(tables) != 1 {
+				t.Fatalf("tables = %d, want 1", len(tables))
+			}
+			if tables[0].Headers[0] != "Product" || tables[0].RowCount() != 2 {
+				t.Errorf("table = %v with %d rows, want the Product table", tables[0].Headers, tables[0].RowCount())
+			}
+		})
+	}
+}
@@ -0,0 +1,123 @@
+package stream
+
+// Aggregator accumulates per-column statistics while streaming rows.
+// Feed each row to Add, then read the results once the stream is done.
+//
+// Example:
+//
+//	agg := stream.NewAggregator("Amount")
+//	err := sr.ForEach(func(row *stream.StreamRow) error {
+//	    agg.Add(row)
+//	    return nil
+//	})
+//	total := agg.SumColumn("Amount")
+//	avg, ok := agg.AvgColumn("Amount")
+type Aggregator struct {
+	columns []string
+	stats   map[string]*columnStats
+}
+
+// columnStats holds the running totals for one column
+type columnStats struct {
+	nonEmpty int
+	numeric  int
+	sum      float64
+	min      float64
+	max      float64
+}
+
+// NewAggregator creates an aggregator for the given columns.
+// With no columns, every column seen in the rows is tracked.
+func NewAggregator(columns ...string) *Aggregator {
+	return &Aggregator{
+		columns: columns,
+		stats:   make(map[string]*columnStats),
+	}
+}
+
+// Add folds a row into the running statistics
+func (a *Aggregator) Add(row *StreamRow) {
+	if row == nil {
+		return
+	}
+	if len(a.columns) == 0 {
+		for header, cell := range row.Values {
+			a.addCell(header, cell)
+		}
+		return
+	}
+	for _, header := range a.columns {
+		if cell, ok := row.Values[header]; ok {
+			a.addCell(header, cell)
+		}
+	}
+}
+
+// addCell updates a column's statistics with one cell
+func (a *Aggregator) addCell(header string, cell StreamCell) {
+	s, ok := a.stats[header]
+	if !ok {
+		s = &columnStats{}
+		a.stats[header] = s
+	}
+	if cell.IsEmpty() {
+		return
+	}
+	s.nonEmpty++
+
+	v, ok := cell.AsFloat()
+	if !ok {
+		return
+	}
+	if s.numeric == 0 || v < s.min {
+		s.min = v
+	}
+	if s.numeric == 0 || v > s.max {
+		s.max = v
+	}
+	s.sum += v
+	s.numeric++
+}
+
+// SumColumn returns the sum of the numeric values in a column
+func (a *Aggregator) SumColumn(header string) float64 {
+	if s, ok := a.stats[header]; ok {
+		return s.sum
+	}
+	return 0
+}
+
+// AvgColumn returns the mean of the numeric values in a column; false if there are none
+func (a *Aggregator) AvgColumn(header string) (float64, bool) {
+	s, ok := a.stats[header]
+	if !ok || s.numeric == 0 {
+		return 0, false
+	}
+	return s.sum / float64(s.numeric), true
+}
+
+// CountNonEmpty returns the number of non-empty cells seen in a column
+func (a *Aggregator) CountNonEmpty(header string) int {
+	if s, ok := a.stats[header]; ok {
+		return s.nonEmpty
+	}
+	return 0
+}
+
+// Min returns the smallest numeric value in a column; false if there are none
+func (a *Aggregator) Min(header string) (float64, bool) {
+	s, ok := a.stats[header]
+	if !ok || s.numeric == 0 {
+		return 0, false
+	}
+	return s.min, true
+}
+
+// Max returns the largest numeric value in a column; false if there are none
+func (a *Aggregator) Max(header string) (float64, bool) {
+	s, ok := a.stats[header]
+	if !ok || s.numeric == 0 {
+		return 0, false
+	}
+	return s.max, true
+}
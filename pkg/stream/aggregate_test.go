@@ -0,0 +1,84 @@
+package stream
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/meddhiazoghlami/goxls/pkg/models"
+	"github.com/xuri/excelize/v2"
+)
+
+func TestAggregator_StreamedColumn(t *testing.T) {
+	path := createTestFile(t, func(f *excelize.File) {
+		f.SetCellValue("Sheet1", "A1", "Item")
+		f.SetCellValue("Sheet1", "B1", "Amount")
+		amounts := []string{"10", "4.5", "", "n/a", "25.5"}
+		for i, amount := range amounts {
+			f.SetCellValue("Sheet1", fmt.Sprintf("A%d", i+2), fmt.Sprintf("item%d", i))
+			f.SetCellValue("Sheet1", fmt.Sprintf("B%d", i+2), amount)
+		}
+	})
+
+	sr, err := NewStreamReader(path, "Sheet1")
+	if err != nil {
+		t.Fatalf("NewStreamReader() error = %v", err)
+	}
+	defer sr.Close()
+
+	agg := NewAggregator("Amount")
+	if err := sr.ForEach(func(row *StreamRow) error {
+		agg.Add(row)
+		return nil
+	}); err != nil {
+		t.Fatalf("ForEach() error = %v", err)
+	}
+
+	if got := agg.SumColumn("Amount"); got != 40 {
+		t.Errorf("SumColumn() = %v, want 40", got)
+	}
+	if got, ok := agg.AvgColumn("Amount"); !ok || got != 40.0/3 {
+		t.Errorf("AvgColumn() = %v, %v, want %v, true", got, ok, 40.0/3)
+	}
+	if got, ok := agg.Min("Amount"); !ok || got != 4.5 {
+		t.Errorf("Min() = %v, %v, want 4.5, true", got, ok)
+	}
+	if got, ok := agg.Max("Amount"); !ok || got != 25.5 {
+		t.Errorf("Max() = %v, %v, want 25.5, true", got, ok)
+	}
+	if got := agg.CountNonEmpty("Amount"); got != 4 {
+		t.Errorf("CountNonEmpty() = %d, want 4", got)
+	}
+
+	// Columns not requested are not tracked
+	if got := agg.CountNonEmpty("Item"); got != 0 {
+		t.Errorf("CountNonEmpty(Item) = %d, want 0", got)
+	}
+}
+
+func TestAggregator_AllColumns(t *testing.T) {
+	agg := NewAggregator()
+	agg.Add(&StreamRow{Values: map[string]StreamCell{
+		"A": {Value: 2.0, Type: models.CellTypeNumber, RawValue: "2"},
+		"B": {Value: "x", Type: models.CellTypeString, RawValue: "x"},
+	}})
+	agg.Add(&StreamRow{Values: map[string]StreamCell{
+		"A": {Value: -1.0, Type: models.CellTypeNumber, RawValue: "-1"},
+	}})
+	agg.Add(nil)
+
+	if got := agg.SumColumn("A"); got != 1 {
+		t.Errorf("SumColumn(A) = %v, want 1", got)
+	}
+	if got, _ := agg.Min("A"); got != -1 {
+		t.Errorf("Min(A) = %v, want -1", got)
+	}
+	if got := agg.CountNonEmpty("B"); got != 1 {
+		t.Errorf("CountNonEmpty(B) = %d, want 1", got)
+	}
+	if _, ok := agg.AvgColumn("B"); ok {
+		t.Error("AvgColumn(B) ok = true for non-numeric column, want false")
+	}
+	if _, ok := agg.Max("Missing"); ok {
+		t.Error("Max(Missing) ok = true, want false")
+	}
+}
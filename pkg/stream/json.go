@@ -0,0 +1,248 @@
+package stream
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"strconv"
+	"time"
+
+	"github.com/meddhiazoghlami/goxls/pkg/models"
+)
+
+// JSONStreamReader provides row-by-row iteration over a JSON array of
+// objects, decoding one object at a time so large files are never held in
+// memory. Rows are StreamRows, so the same processing code works for Excel
+// and JSON sources.
+type JSONStreamReader struct {
+	dec        *json.Decoder
+	headers    []string
+	known      map[string]bool
+	typeInfer  *TypeInferrer
+	pending    *StreamRow // First row, read ahead to learn the headers
+	dataRowNum int
+	done       bool
+	err        error
+}
+
+// NewJSONStreamReader creates a streaming reader over JSON from r. The input
+// is either an array of objects or an object holding that array under "rows",
+// as written by the JSON exporter. Headers are taken from the envelope's
+// "headers" when present, otherwise from the keys of the objects in the order
+// they first appear.
+//
+// Example:
+//
+//	jr, err := stream.NewJSONStreamReader(f)
+//	if err != nil {
+//	    log.Fatal(err)
+//	}
+//	err = jr.ForEach(func(row *stream.StreamRow) error {
+//	    agg.Add(row)
+//	    return nil
+//	})
+func NewJSONStreamReader(r io.Reader) (*JSONStreamReader, error) {
+	dec := json.NewDecoder(r)
+	dec.UseNumber()
+
+	jr := &JSONStreamReader{
+		dec:       dec,
+		known:     make(map[string]bool),
+		typeInfer: NewTypeInferrer(nil),
+	}
+	if err := jr.openArray(); err != nil {
+		return nil, err
+	}
+
+	// Read the first row ahead so Headers is available before Next
+	row, err := jr.next()
+	if err != nil && err != io.EOF {
+		return nil, err
+	}
+	jr.pending = row
+	return jr, nil
+}
+
+// openArray consumes input up to the opening bracket of the rows array
+func (jr *JSONStreamReader) openArray() error {
+	tok, err := jr.dec.Token()
+	if err != nil {
+		return fmt.Errorf("goxls: failed to read JSON: %w", err)
+	}
+	if tok == json.Delim('[') {
+		return nil
+	}
+	if tok != json.Delim('{') {
+		return fmt.Errorf("goxls: JSON input is not an array or object")
+	}
+
+	for jr.dec.More() {
+		key, err := jr.dec.Token()
+		if err != nil {
+			return fmt.Errorf("goxls: failed to read JSON: %w", err)
+		}
+		switch key {
+		case "rows":
+			tok, err := jr.dec.Token()
+			if err != nil {
+				return fmt.Errorf("goxls: failed to read JSON: %w", err)
+			}
+			if tok != json.Delim('[') {
+				return fmt.Errorf("goxls: JSON rows is not an array")
+			}
+			return nil
+		case "headers":
+			var headers []string
+			if err := jr.dec.Decode(&headers); err != nil {
+				return fmt.Errorf("goxls: failed to read JSON headers: %w", err)
+			}
+			for _, h := range headers {
+				jr.addHeader(h)
+			}
+		default:
+			var skip json.RawMessage
+			if err := jr.dec.Decode(&skip); err != nil {
+				return fmt.Errorf("goxls: failed to read JSON: %w", err)
+			}
+		}
+	}
+	return fmt.Errorf("goxls: JSON object has no rows array")
+}
+
+// Next advances to the next row and returns it.
+// Returns io.EOF when no more rows are available.
+func (jr *JSONStreamReader) Next() (*StreamRow, error) {
+	if jr.pending != nil {
+		row := jr.pending
+		jr.pending = nil
+		return row, nil
+	}
+	return jr.next()
+}
+
+// next decodes the next object of the array into a row
+func (jr *JSONStreamReader) next() (*StreamRow, error) {
+	if jr.err != nil {
+		return nil, jr.err
+	}
+	if jr.done || !jr.dec.More() {
+		jr.done = true
+		return nil, io.EOF
+	}
+
+	tok, err := jr.dec.Token()
+	if err != nil {
+		jr.err = fmt.Errorf("goxls: stream read error: %w", err)
+		return nil, jr.err
+	}
+	if tok != json.Delim('{') {
+		jr.err = fmt.Errorf("goxls: JSON row %d is not an object", jr.dataRowNum)
+		return nil, jr.err
+	}
+
+	values := make(map[string]interface{})
+	for jr.dec.More() {
+		key, err := jr.dec.Token()
+		if err != nil {
+			jr.err = fmt.Errorf("goxls: stream read error: %w", err)
+			return nil, jr.err
+		}
+		name, _ := key.(string)
+		var value interface{}
+		if err := jr.dec.Decode(&value); err != nil {
+			jr.err = fmt.Errorf("goxls: stream read error: %w", err)
+			return nil, jr.err
+		}
+		jr.addHeader(name)
+		values[name] = value
+	}
+	if _, err := jr.dec.Token(); err != nil {
+		jr.err = fmt.Errorf("goxls: stream read error: %w", err)
+		return nil, jr.err
+	}
+
+	row := &StreamRow{
+		Index:  jr.dataRowNum,
+		Values: make(map[string]StreamCell, len(jr.headers)),
+		Cells:  make([]StreamCell, len(jr.headers)),
+	}
+	for i, h := range jr.headers {
+		cell := jr.cell(values[h])
+		cell.ColIndex = i
+		row.Cells[i] = cell
+		row.Values[h] = cell
+	}
+	jr.dataRowNum++
+	return row, nil
+}
+
+// addHeader appends a header the first time it is seen
+func (jr *JSONStreamReader) addHeader(name string) {
+	if !jr.known[name] {
+		jr.known[name] = true
+		jr.headers = append(jr.headers, name)
+	}
+}
+
+// cell converts a decoded JSON value to a StreamCell. Strings holding dates
+// in RFC 3339 or a common date layout become dates; nested arrays and
+// objects are kept as their JSON text.
+func (jr *JSONStreamReader) cell(value interface{}) StreamCell {
+	switch v := value.(type) {
+	case nil:
+		return StreamCell{Type: models.CellTypeEmpty}
+	case json.Number:
+		if f, err := v.Float64(); err == nil {
+			return StreamCell{Value: f, Type: models.CellTypeNumber, RawValue: v.String()}
+		}
+		return StreamCell{Value: v.String(), Type: models.CellTypeString, RawValue: v.String()}
+	case bool:
+		return StreamCell{Value: v, Type: models.CellTypeBool, RawValue: strconv.FormatBool(v)}
+	case string:
+		if v == "" {
+			return StreamCell{Type: models.CellTypeEmpty}
+		}
+		if t, err := time.Parse(time.RFC3339, v); err == nil {
+			return StreamCell{Value: t, Type: models.CellTypeDate, RawValue: v}
+		}
+		if t, ok := jr.typeInfer.parseDate(v).(time.Time); ok {
+			return StreamCell{Value: t, Type: models.CellTypeDate, RawValue: v}
+		}
+		return StreamCell{Value: v, Type: models.CellTypeString, RawValue: v}
+	default:
+		raw, _ := json.Marshal(v)
+		return StreamCell{Value: string(raw), Type: models.CellTypeString, RawValue: string(raw)}
+	}
+}
+
+// Headers returns the column headers seen so far, in order of first appearance
+func (jr *JSONStreamReader) Headers() []string {
+	result := make([]string, len(jr.headers))
+	copy(result, jr.headers)
+	return result
+}
+
+// TotalRowsRead returns the count of rows decoded so far
+func (jr *JSONStreamReader) TotalRowsRead() int {
+	if jr.pending != nil {
+		return jr.dataRowNum - 1
+	}
+	return jr.dataRowNum
+}
+
+// ForEach iterates over all rows and calls the provided function for each.
+// Iteration stops if the function returns an error.
+func (jr *JSONStreamReader) ForEach(fn func(*StreamRow) error) error {
+	for {
+		row, err := jr.Next()
+		if err == io.EOF {
+			return nil
+		}
+		if err != nil {
+			return err
+		}
+		if err := fn(row); err != nil {
+			return err
+		}
+	}
+}
@@ -0,0 +1,105 @@
+package stream
+
+import (
+	"io"
+	"reflect"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/meddhiazoghlami/goxls/pkg/models"
+)
+
+func TestJSONStreamReader_Array(t *testing.T) {
+	input := `[
+		{"Name": "Alice", "Amount": 10.5, "Active": true, "Joined": "2023-01-15T00:00:00Z"},
+		{"Name": "Bob", "Amount": 4, "Active": false, "Joined": null},
+		{"Name": "Charlie", "Amount": 25.5, "Note": "late"}
+	]`
+
+	jr, err := NewJSONStreamReader(strings.NewReader(input))
+	if err != nil {
+		t.Fatalf("NewJSONStreamReader() error = %v", err)
+	}
+	if got := jr.Headers(); !reflect.DeepEqual(got, []string{"Name", "Amount", "Active", "Joined"}) {
+		t.Errorf("Headers() = %v, want the first object's keys", got)
+	}
+
+	agg := NewAggregator("Amount")
+	count := 0
+	var last *StreamRow
+	if err := jr.ForEach(func(row *StreamRow) error {
+		agg.Add(row)
+		count++
+		last = row
+		return nil
+	}); err != nil {
+		t.Fatalf("ForEach() error = %v", err)
+	}
+
+	if count != 3 || jr.TotalRowsRead() != 3 {
+		t.Errorf("rows = %d, TotalRowsRead() = %d, want 3", count, jr.TotalRowsRead())
+	}
+	if sum := agg.SumColumn("Amount"); sum != 40 {
+		t.Errorf("SumColumn(Amount) = %v, want 40", sum)
+	}
+	if note, ok := last.String("Note"); !ok || note != "late" {
+		t.Errorf("last Note = %q, want late", note)
+	}
+	if _, err := jr.Next(); err != io.EOF {
+		t.Errorf("Next() after the last row error = %v, want io.EOF", err)
+	}
+}
+
+func TestJSONStreamReader_Envelope(t *testing.T) {
+	input := `{"count": 2, "headers": ["ID", "Joined"], "name": "People", "rows": [
+		{"Joined": "2023-01-15T00:00:00Z", "ID": 1},
+		{"ID": 2, "Joined": null}
+	]}`
+
+	jr, err := NewJSONStreamReader(strings.NewReader(input))
+	if err != nil {
+		t.Fatalf("NewJSONStreamReader() error = %v", err)
+	}
+	if got := jr.Headers(); !reflect.DeepEqual(got, []string{"ID", "Joined"}) {
+		t.Errorf("Headers() = %v, want [ID Joined] from the envelope", got)
+	}
+
+	row, err := jr.Next()
+	if err != nil {
+		t.Fatalf("Next() error = %v", err)
+	}
+	if row.Cells[0].Type != models.CellTypeNumber || row.Cells[1].Type != models.CellTypeDate {
+		t.Errorf("cell types = %v, %v, want number and date", row.Cells[0].Type, row.Cells[1].Type)
+	}
+	if joined, _ := row.Time("Joined"); !joined.Equal(time.Date(2023, 1, 15, 0, 0, 0, 0, time.UTC)) {
+		t.Errorf("Joined = %v, want 2023-01-15", joined)
+	}
+
+	row, err = jr.Next()
+	if err != nil {
+		t.Fatalf("Next() error = %v", err)
+	}
+	if cell, _ := row.Get("Joined"); !cell.IsEmpty() {
+		t.Errorf("null Joined = %+v, want an empty cell", cell)
+	}
+}
+
+func TestJSONStreamReader_InvalidInput(t *testing.T) {
+	for _, input := range []string{``, `42`, `{"name": "x"}`, `{"rows": 3}`} {
+		if _, err := NewJSONStreamReader(strings.NewReader(input)); err == nil {
+			t.Errorf("NewJSONStreamReader(%q) error = nil, want an error", input)
+		}
+	}
+
+	jr, err := NewJSONStreamReader(strings.NewReader(`[{"a": 1}, 2]`))
+	if err != nil {
+		t.Fatalf("NewJSONStreamReader() error = %v", err)
+	}
+	if _, err := jr.Next(); err != nil {
+		t.Fatalf("first Next() error = %v", err)
+	}
+	if _, err := jr.Next(); err == nil || err == io.EOF {
+		t.Errorf("Next() on a non-object error = %v, want a read error", err)
+	}
+}
@@ -19,6 +19,9 @@
 //	    }
 //	    // Process row
 //	}
+//
+// NewJSONStreamReader yields the same StreamRows from a JSON array of objects,
+// such as the output of the JSON exporter.
 package stream
 
 import (
@@ -26,8 +29,10 @@ import (
 	"errors"
 	"fmt"
 	"io"
+	"math"
 	"os"
 	"strings"
+	"time"
 
 	"github.com/meddhiazoghlami/goxls/pkg/models"
 	"github.com/xuri/excelize/v2"
@@ -121,6 +126,30 @@ func (c *StreamCell) AsFloat() (float64, bool) {
 	return 0, false
 }
 
+// AsInt returns the cell value as an int if it's a whole number
+func (c *StreamCell) AsInt() (int, bool) {
+	if v, ok := c.Value.(float64); ok && v == math.Trunc(v) {
+		return int(v), true
+	}
+	return 0, false
+}
+
+// AsBool returns the cell value as a bool if it's boolean
+func (c *StreamCell) AsBool() (bool, bool) {
+	if v, ok := c.Value.(bool); ok {
+		return v, true
+	}
+	return false, false
+}
+
+// AsTime returns the cell value as a time.Time if it's a date
+func (c *StreamCell) AsTime() (time.Time, bool) {
+	if v, ok := c.Value.(time.Time); ok {
+		return v, true
+	}
+	return time.Time{}, false
+}
+
 // StreamRow represents a single row from streaming
 type StreamRow struct {
 	// Index is the 0-indexed row number in the file (after skipped rows)
@@ -139,6 +168,39 @@ func (r *StreamRow) Get(header string) (StreamCell, bool) {
 	return cell, ok
 }
 
+// String returns the named column as a string; false if the column is missing
+func (r *StreamRow) String(header string) (string, bool) {
+	cell, ok := r.Values[header]
+	if !ok {
+		return "", false
+	}
+	return cell.AsString(), true
+}
+
+// Float returns the named column as a float64 if it's numeric
+func (r *StreamRow) Float(header string) (float64, bool) {
+	cell := r.Values[header]
+	return cell.AsFloat()
+}
+
+// Int returns the named column as an int if it's a whole number
+func (r *StreamRow) Int(header string) (int, bool) {
+	cell := r.Values[header]
+	return cell.AsInt()
+}
+
+// Bool returns the named column as a bool if it's boolean
+func (r *StreamRow) Bool(header string) (bool, bool) {
+	cell := r.Values[header]
+	return cell.AsBool()
+}
+
+// Time returns the named column as a time.Time if it's a date
+func (r *StreamRow) Time(header string) (time.Time, bool) {
+	cell := r.Values[header]
+	return cell.AsTime()
+}
+
 // IsEmpty returns true if all cells in the row are empty
 func (r *StreamRow) IsEmpty() bool {
 	for _, cell := range r.Cells {
@@ -781,6 +781,66 @@ func TestStreamRow_Get(t *testing.T) {
 	}
 }
 
+func TestStreamRow_TypedAccessors(t *testing.T) {
+	path := createTestFile(t, func(f *excelize.File) {
+		f.SetCellValue("Sheet1", "A1", "Name")
+		f.SetCellValue("Sheet1", "B1", "Qty")
+		f.SetCellValue("Sheet1", "C1", "Price")
+		f.SetCellValue("Sheet1", "D1", "Active")
+		f.SetCellValue("Sheet1", "E1", "Date")
+		f.SetCellValue("Sheet1", "A2", "Widget")
+		f.SetCellValue("Sheet1", "B2", "3")
+		f.SetCellValue("Sheet1", "C2", "9.5")
+		f.SetCellValue("Sheet1", "D2", "true")
+		f.SetCellValue("Sheet1", "E2", "2024-03-15")
+	})
+
+	sr, err := NewStreamReader(path, "Sheet1")
+	if err != nil {
+		t.Fatalf("NewStreamReader() error = %v", err)
+	}
+	defer sr.Close()
+
+	row, err := sr.Next()
+	if err != nil {
+		t.Fatalf("Next() error = %v", err)
+	}
+
+	if s, ok := row.String("Name"); !ok || s != "Widget" {
+		t.Errorf("String(Name) = %q, %v, want %q, true", s, ok, "Widget")
+	}
+	if n, ok := row.Int("Qty"); !ok || n != 3 {
+		t.Errorf("Int(Qty) = %d, %v, want 3, true", n, ok)
+	}
+	if f, ok := row.Float("Price"); !ok || f != 9.5 {
+		t.Errorf("Float(Price) = %f, %v, want 9.5, true", f, ok)
+	}
+	if b, ok := row.Bool("Active"); !ok || !b {
+		t.Errorf("Bool(Active) = %v, %v, want true, true", b, ok)
+	}
+	want := time.Date(2024, 3, 15, 0, 0, 0, 0, time.UTC)
+	if d, ok := row.Time("Date"); !ok || !d.Equal(want) {
+		t.Errorf("Time(Date) = %v, %v, want %v, true", d, ok, want)
+	}
+
+	// Wrong type or missing column
+	if _, ok := row.Int("Price"); ok {
+		t.Error("Int(Price) ok = true for 9.5, want false")
+	}
+	if _, ok := row.Float("Name"); ok {
+		t.Error("Float(Name) ok = true for string, want false")
+	}
+	if _, ok := row.Bool("Qty"); ok {
+		t.Error("Bool(Qty) ok = true for number, want false")
+	}
+	if _, ok := row.Time("Name"); ok {
+		t.Error("Time(Name) ok = true for string, want false")
+	}
+	if _, ok := row.String("Missing"); ok {
+		t.Error("String(Missing) ok = true, want false")
+	}
+}
+
 func TestStreamRow_IsEmpty(t *testing.T) {
 	emptyRow := &StreamRow{
 		Cells: []StreamCell{
@@ -35,8 +35,14 @@
 //
 //   - Required: Field cannot be empty
 //   - MatchesPattern: Value must match regex pattern
-//   - Range: Numeric value must be within min/max bounds
+//   - Email, URL, UUID: Value must be an email address, http(s) URL or UUID
+//     (ASCII only; internationalized addresses and IPv6 hosts are not accepted)
+//   - Range: Numeric value must be within min/max bounds; NaN and infinities fail
+//   - RangeExclusive: Numeric value must be strictly between min and max
+//   - DateBefore, DateAfter, DateBetween: Date must fall in the range; text is
+//     parsed with the reader's date layouts
 //   - OneOf: Value must be in allowed list
+//   - OneOfTable: Value must appear in a column of another table
 //   - Custom: Custom validation function
 //
 // # Custom Validation
@@ -53,10 +59,58 @@
 //	    },
 //	}
 //
+// Use CustomRowFunc for checks that depend on other columns in the row:
+//
+//	rule := validation.ForColumn("Total").CustomRow(func(row models.Row) error {
+//	    price, _ := row.Get("Price")
+//	    qty, _ := row.Get("Quantity")
+//	    total, _ := row.Get("Total")
+//	    p, _ := price.AsFloat()
+//	    q, _ := qty.AsFloat()
+//	    if t, _ := total.AsFloat(); t != p*q {
+//	        return errors.New("total does not equal price * quantity")
+//	    }
+//	    return nil
+//	}).Build()
+//
+// # Custom Messages
+//
+// MessageTemplate replaces a rule's default messages. The placeholders
+// {value}, {column}, {row}, {cell}, {min} and {max} are filled in per failure:
+//
+//	rule := validation.ForColumn("Age").Min(18).
+//	    Message("{column} {value} below minimum {min}").
+//	    Build() // "Age 16 below minimum 18"
+//
+// # Table Rules
+//
+// Table rules are checked once per table. Their errors have Row set to -1:
+//
+//	result := validation.ValidateTable(table, rules,
+//	    validation.RequireMinRows(1),
+//	    validation.RequireExactRows(12),
+//	    validation.ColumnSumEquals("Amount", 10000, 0.01),
+//	)
+//
 // # Error Grouping
 //
 // Group validation errors for analysis:
 //
 //	byColumn := result.ErrorsByColumn()  // map[string][]ValidationError
 //	byRow := result.ErrorsByRow()        // map[int][]ValidationError
+//
+// # Filtering Rows
+//
+// Split a table into passing and failing rows, e.g. to quarantine bad records:
+//
+//	bad := validation.FilterInvalidRows(table, rules)
+//	good := validation.FilterValidRows(table, rules)
+//
+// # Reports
+//
+// Results serialize for CI tooling. ToJUnitXML reports each error as a
+// failing testcase:
+//
+//	data, err := result.ToJSON()
+//	report, err := result.ToJUnitXML("customers.xlsx")
 package validation
@@ -0,0 +1,96 @@
+package validation
+
+import (
+	"encoding/json"
+	"encoding/xml"
+	"fmt"
+)
+
+// jsonResult is the JSON form of a ValidationResult
+type jsonResult struct {
+	Valid  bool        `json:"valid"`
+	Errors []jsonError `json:"errors"`
+}
+
+// jsonError is the JSON form of a ValidationError
+type jsonError struct {
+	Row     int    `json:"row"`
+	Column  string `json:"column"`
+	Value   string `json:"value"`
+	Message string `json:"message"`
+	CellRef string `json:"cellRef,omitempty"`
+}
+
+// ToJSON returns the result as a JSON document with a "valid" flag and an
+// "errors" array, for CI dashboards and other tooling
+func (vr ValidationResult) ToJSON() ([]byte, error) {
+	out := jsonResult{Valid: vr.Valid, Errors: make([]jsonError, len(vr.Errors))}
+	for i, e := range vr.Errors {
+		out.Errors[i] = jsonError{
+			Row:     e.Row,
+			Column:  e.Column,
+			Value:   e.Value,
+			Message: e.Message,
+			CellRef: e.CellRef,
+		}
+	}
+	return json.MarshalIndent(out, "", "  ")
+}
+
+// junitSuite is a JUnit XML testsuite element
+type junitSuite struct {
+	XMLName   xml.Name    `xml:"testsuite"`
+	Name      string      `xml:"name,attr"`
+	Tests     int         `xml:"tests,attr"`
+	Failures  int         `xml:"failures,attr"`
+	TestCases []junitCase `xml:"testcase"`
+}
+
+// junitCase is a JUnit XML testcase element
+type junitCase struct {
+	Name      string        `xml:"name,attr"`
+	ClassName string        `xml:"classname,attr"`
+	Failure   *junitFailure `xml:"failure,omitempty"`
+}
+
+// junitFailure is a JUnit XML failure element
+type junitFailure struct {
+	Message string `xml:"message,attr"`
+	Text    string `xml:",chardata"`
+}
+
+// ToJUnitXML returns the result as a JUnit testsuite in which every validation
+// error is a failing testcase. A valid result yields a single passing testcase
+// so reporters still show the suite.
+func (vr ValidationResult) ToJUnitXML(suiteName string) ([]byte, error) {
+	suite := junitSuite{Name: suiteName, Failures: len(vr.Errors)}
+	for _, e := range vr.Errors {
+		suite.TestCases = append(suite.TestCases, junitCase{
+			Name:      junitCaseName(e),
+			ClassName: suiteName,
+			Failure:   &junitFailure{Message: e.Message, Text: e.Error()},
+		})
+	}
+	if len(suite.TestCases) == 0 {
+		suite.TestCases = append(suite.TestCases, junitCase{Name: "validation", ClassName: suiteName})
+	}
+	suite.Tests = len(suite.TestCases)
+
+	out, err := xml.MarshalIndent(suite, "", "  ")
+	if err != nil {
+		return nil, err
+	}
+	return append([]byte(xml.Header), out...), nil
+}
+
+// junitCaseName names the testcase for a validation error
+func junitCaseName(e ValidationError) string {
+	switch {
+	case e.Row < 0 && e.Column == "":
+		return "table"
+	case e.CellRef != "":
+		return fmt.Sprintf("%s %s", e.Column, e.CellRef)
+	default:
+		return fmt.Sprintf("%s row %d", e.Column, e.Row)
+	}
+}
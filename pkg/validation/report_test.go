@@ -0,0 +1,84 @@
+package validation
+
+import (
+	"encoding/json"
+	"encoding/xml"
+	"reflect"
+	"strings"
+	"testing"
+)
+
+func sampleResult() ValidationResult {
+	return ValidationResult{
+		Valid: false,
+		Errors: []ValidationError{
+			{Row: 0, Column: "Email", Value: "bad", Message: "value is not a valid email address", CellRef: "B2"},
+			{Row: 2, Column: "Age", Value: "-1", Message: "value -1 is less than minimum 0"},
+			{Row: -1, Message: "table has 3 rows, want at least 5"},
+		},
+	}
+}
+
+func TestValidationResult_ToJSON(t *testing.T) {
+	result := sampleResult()
+
+	data, err := result.ToJSON()
+	if err != nil {
+		t.Fatalf("ToJSON() error = %v", err)
+	}
+	if !strings.Contains(string(data), `"cellRef": "B2"`) {
+		t.Errorf("ToJSON() = %s, want cellRef key", data)
+	}
+
+	var decoded ValidationResult
+	if err := json.Unmarshal(data, &decoded); err != nil {
+		t.Fatalf("json.Unmarshal() error = %v", err)
+	}
+	if !reflect.DeepEqual(decoded, result) {
+		t.Errorf("round trip = %+v, want %+v", decoded, result)
+	}
+}
+
+func TestValidationResult_ToJUnitXML(t *testing.T) {
+	data, err := sampleResult().ToJUnitXML("users.xlsx")
+	if err != nil {
+		t.Fatalf("ToJUnitXML() error = %v", err)
+	}
+	if !strings.HasPrefix(string(data), "<?xml") {
+		t.Error("ToJUnitXML() should start with an XML header")
+	}
+
+	var suite struct {
+		Name      string `xml:"name,attr"`
+		Tests     int    `xml:"tests,attr"`
+		Failures  int    `xml:"failures,attr"`
+		TestCases []struct {
+			Name    string `xml:"name,attr"`
+			Failure *struct {
+				Message string `xml:"message,attr"`
+			} `xml:"failure"`
+		} `xml:"testcase"`
+	}
+	if err := xml.Unmarshal(data, &suite); err != nil {
+		t.Fatalf("xml.Unmarshal() error = %v", err)
+	}
+
+	if suite.Name != "users.xlsx" || suite.Tests != 3 || suite.Failures != 3 {
+		t.Errorf("testsuite = name %q tests %d failures %d, want users.xlsx 3 3", suite.Name, suite.Tests, suite.Failures)
+	}
+	if suite.TestCases[0].Name != "Email B2" || suite.TestCases[0].Failure == nil {
+		t.Errorf("testcase[0] = %+v, want failing Email B2", suite.TestCases[0])
+	}
+	if suite.TestCases[2].Name != "table" {
+		t.Errorf("testcase[2].Name = %q, want table", suite.TestCases[2].Name)
+	}
+
+	// A valid result is reported as one passing testcase
+	data, err = ValidationResult{Valid: true}.ToJUnitXML("ok")
+	if err != nil {
+		t.Fatalf("ToJUnitXML() error = %v", err)
+	}
+	if !strings.Contains(string(data), `tests="1" failures="0"`) || strings.Contains(string(data), "<failure") {
+		t.Errorf("ToJUnitXML() for valid result = %s", data)
+	}
+}
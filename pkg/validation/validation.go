@@ -2,10 +2,14 @@ package validation
 
 import (
 	"fmt"
+	"math"
 	"regexp"
+	"strconv"
 	"strings"
+	"time"
 
 	"github.com/meddhiazoghlami/goxls/pkg/models"
+	"github.com/xuri/excelize/v2"
 )
 
 // ValidationRule defines validation criteria for a column
@@ -13,24 +17,143 @@ type ValidationRule struct {
 	Column        string         // Column header name to validate
 	Required      bool           // If true, empty values are not allowed
 	Pattern       *regexp.Regexp // Regex pattern the value must match
+	PatternName   string         // What Pattern describes, e.g. "email address", used in error messages
 	MinVal        float64        // Minimum numeric value (only checked if MinValSet is true)
 	MaxVal        float64        // Maximum numeric value (only checked if MaxValSet is true)
 	MinValSet     bool           // Whether MinVal should be checked
 	MaxValSet     bool           // Whether MaxVal should be checked
+	MinExclusive  bool           // If true, a value equal to MinVal is rejected
+	MaxExclusive  bool           // If true, a value equal to MaxVal is rejected
 	AllowedValues []string       // List of allowed values (case-sensitive)
 	CustomFunc    func(cell models.Cell) error // Custom validation function
+	CustomRowFunc func(row models.Row) error   // Custom validation function with access to the full row
+	RefTable      *models.Table                // Table whose RefColumn values are the allowed values
+	RefColumn     string                       // Column of RefTable holding the allowed values
+
+	MinDate          time.Time // Earliest allowed date (zero = unchecked)
+	MaxDate          time.Time // Latest allowed date (zero = unchecked)
+	MinDateExclusive bool      // If true, a date equal to MinDate is rejected
+	MaxDateExclusive bool      // If true, a date equal to MaxDate is rejected
+
+	// MessageTemplate replaces the default message of every failure of this
+	// rule. The placeholders {value}, {column}, {row}, {cell}, {min} and {max}
+	// are filled in per failure; {min} and {max} are empty unless set.
+	MessageTemplate string
+
+	refValues map[string]bool // Allowed values from RefTable, resolved at validation time
+}
+
+// TableRule defines a check evaluated once per table rather than per cell
+type TableRule struct {
+	Name  string                          // Short description of the rule
+	Check func(table *models.Table) error // Returns an error if the table fails the rule
+}
+
+// RequireMinRows creates a table rule requiring at least n data rows
+func RequireMinRows(n int) TableRule {
+	return TableRule{
+		Name: fmt.Sprintf("min rows %d", n),
+		Check: func(table *models.Table) error {
+			if count := tableRowCount(table); count < n {
+				return fmt.Errorf("table has %d rows, want at least %d", count, n)
+			}
+			return nil
+		},
+	}
+}
+
+// RequireExactRows creates a table rule requiring exactly n data rows
+func RequireExactRows(n int) TableRule {
+	return TableRule{
+		Name: fmt.Sprintf("exact rows %d", n),
+		Check: func(table *models.Table) error {
+			if count := tableRowCount(table); count != n {
+				return fmt.Errorf("table has %d rows, want exactly %d", count, n)
+			}
+			return nil
+		},
+	}
+}
+
+// ColumnSumEquals creates a table rule requiring the numeric values of a column
+// to sum to expected, give or take tolerance. Non-numeric cells are ignored.
+func ColumnSumEquals(column string, expected, tolerance float64) TableRule {
+	return TableRule{
+		Name: fmt.Sprintf("sum of %s equals %g", column, expected),
+		Check: func(table *models.Table) error {
+			sum, err := columnSum(table, column)
+			if err != nil {
+				return err
+			}
+			if math.Abs(sum-expected) > tolerance {
+				return fmt.Errorf("sum of %s is %g, want %g (tolerance %g)", column, sum, expected, tolerance)
+			}
+			return nil
+		},
+	}
+}
+
+// ColumnSumInRange creates a table rule requiring the numeric values of a column
+// to sum to between min and max inclusive. Non-numeric cells are ignored.
+func ColumnSumInRange(column string, min, max float64) TableRule {
+	return TableRule{
+		Name: fmt.Sprintf("sum of %s in [%g, %g]", column, min, max),
+		Check: func(table *models.Table) error {
+			sum, err := columnSum(table, column)
+			if err != nil {
+				return err
+			}
+			if sum < min || sum > max {
+				return fmt.Errorf("sum of %s is %g, want between %g and %g", column, sum, min, max)
+			}
+			return nil
+		},
+	}
+}
+
+// columnSum adds up the numeric cells of a column
+func columnSum(table *models.Table, column string) (float64, error) {
+	var cells []models.Cell
+	if table != nil {
+		cells = table.ColumnValues(column)
+	}
+	if cells == nil {
+		return 0, fmt.Errorf("column %s not found", column)
+	}
+	sum := 0.0
+	for _, cell := range cells {
+		if f, ok := cell.AsFloat(); ok {
+			sum += f
+		}
+	}
+	return sum, nil
+}
+
+// tableRowCount returns the row count of a possibly nil table
+func tableRowCount(table *models.Table) int {
+	if table == nil {
+		return 0
+	}
+	return table.RowCount()
 }
 
 // ValidationError represents a single validation failure
 type ValidationError struct {
-	Row     int    // Row index (0-based, relative to data rows, not including header)
+	Row     int    // Row index (0-based, relative to data rows, not including header); -1 for table rules
 	Column  string // Column name
 	Value   string // The invalid value
 	Message string // Description of the validation failure
+	CellRef string // A1-style reference of the cell in the sheet (e.g., "C5")
 }
 
 // Error implements the error interface
 func (ve ValidationError) Error() string {
+	if ve.Row < 0 && ve.Column == "" {
+		return fmt.Sprintf("table: %s", ve.Message)
+	}
+	if ve.CellRef != "" {
+		return fmt.Sprintf("row %d, column %q (%s): %s (value: %q)", ve.Row, ve.Column, ve.CellRef, ve.Message, ve.Value)
+	}
 	return fmt.Sprintf("row %d, column %q: %s (value: %q)", ve.Row, ve.Column, ve.Message, ve.Value)
 }
 
@@ -60,7 +183,8 @@ func (vr ValidationResult) ErrorsByRow() map[int][]ValidationError {
 
 // Validator performs validation on tables
 type Validator struct {
-	rules []ValidationRule
+	rules      []ValidationRule
+	tableRules []TableRule
 }
 
 // NewValidator creates a new validator with the given rules
@@ -68,28 +192,58 @@ func NewValidator(rules []ValidationRule) *Validator {
 	return &Validator{rules: rules}
 }
 
+// AddTableRules adds rules evaluated once per table
+func (v *Validator) AddTableRules(rules ...TableRule) *Validator {
+	v.tableRules = append(v.tableRules, rules...)
+	return v
+}
+
 // Validate validates a table against the configured rules
 func (v *Validator) Validate(table *models.Table) ValidationResult {
 	result := ValidationResult{Valid: true}
 
+	// Table rules run even for empty tables, which they may reject
+	for _, rule := range v.tableRules {
+		if rule.Check == nil {
+			continue
+		}
+		if err := rule.Check(table); err != nil {
+			result.Errors = append(result.Errors, ValidationError{
+				Row:     -1,
+				Message: err.Error(),
+			})
+			result.Valid = false
+		}
+	}
+
 	if table == nil || len(table.Rows) == 0 {
 		return result
 	}
 
 	for _, rule := range v.rules {
 		// Check if the column exists
-		columnExists := false
-		for _, h := range table.Headers {
+		colIdx := -1
+		for i, h := range table.Headers {
 			if h == rule.Column {
-				columnExists = true
+				colIdx = i
 				break
 			}
 		}
-		if !columnExists {
+		if colIdx == -1 {
 			continue // Skip rules for non-existent columns
 		}
+		sheetCol := table.StartCol + colIdx
+		if colIdx < len(table.HeaderCells) {
+			sheetCol = table.HeaderCells[colIdx].Col
+		}
+
+		if rule.RefTable != nil {
+			rule.refValues = referenceValues(rule.RefTable, rule.RefColumn)
+		}
 
 		for rowIdx, row := range table.Rows {
+			ref := cellRef(table, row, rowIdx, sheetCol)
+
 			cell, exists := row.Values[rule.Column]
 			if !exists {
 				if rule.Required {
@@ -97,14 +251,19 @@ func (v *Validator) Validate(table *models.Table) ValidationResult {
 						Row:     rowIdx,
 						Column:  rule.Column,
 						Value:   "",
-						Message: "required field is missing",
+						Message: rule.message("required field is missing", rowIdx, "", ref),
+						CellRef: ref,
 					})
 					result.Valid = false
 				}
 				continue
 			}
 
-			errors := v.validateCell(cell, rule, rowIdx)
+			errors := v.validateCell(cell, row, rule, rowIdx)
+			for i := range errors {
+				errors[i].CellRef = ref
+				errors[i].Message = rule.message(errors[i].Message, rowIdx, errors[i].Value, ref)
+			}
 			if len(errors) > 0 {
 				result.Errors = append(result.Errors, errors...)
 				result.Valid = false
@@ -115,8 +274,88 @@ func (v *Validator) Validate(table *models.Table) ValidationResult {
 	return result
 }
 
+// cellRef returns the A1 reference of a data cell given its 0-based sheet column.
+// Rows read from a sheet carry their grid index; rows built by hand fall back
+// to their position below the header row.
+func cellRef(table *models.Table, row models.Row, rowIdx, sheetCol int) string {
+	sheetRow := row.Index
+	if sheetRow <= table.HeaderRow {
+		sheetRow = table.HeaderRow + 1 + rowIdx
+	}
+	ref, err := excelize.CoordinatesToCellName(sheetCol+1, sheetRow+1)
+	if err != nil {
+		return ""
+	}
+	return ref
+}
+
+// message renders the rule's MessageTemplate for a failure, or returns the
+// default message when no template is set
+func (rule ValidationRule) message(defaultMessage string, rowIdx int, value, ref string) string {
+	if rule.MessageTemplate == "" {
+		return defaultMessage
+	}
+	var min, max string
+	if rule.MinValSet {
+		min = fmt.Sprintf("%v", rule.MinVal)
+	} else if !rule.MinDate.IsZero() {
+		min = formatRuleDate(rule.MinDate)
+	}
+	if rule.MaxValSet {
+		max = fmt.Sprintf("%v", rule.MaxVal)
+	} else if !rule.MaxDate.IsZero() {
+		max = formatRuleDate(rule.MaxDate)
+	}
+	return strings.NewReplacer(
+		"{value}", value,
+		"{column}", rule.Column,
+		"{row}", strconv.Itoa(rowIdx),
+		"{cell}", ref,
+		"{min}", min,
+		"{max}", max,
+	).Replace(rule.MessageTemplate)
+}
+
+// formatRuleDate formats a date for a message, without the time of day at midnight
+func formatRuleDate(t time.Time) string {
+	if t.Hour() == 0 && t.Minute() == 0 && t.Second() == 0 {
+		return t.Format("2006-01-02")
+	}
+	return t.Format("2006-01-02 15:04:05")
+}
+
+// cellDate returns a cell's date, parsing text with the layouts used for type inference
+func cellDate(cell models.Cell) (time.Time, bool) {
+	if t, ok := cell.AsTime(); ok {
+		return t, true
+	}
+	if s, ok := cell.Value.(string); ok {
+		return models.ParseDate(strings.TrimSpace(s))
+	}
+	return time.Time{}, false
+}
+
+// patternMessage describes a failed pattern check
+func patternMessage(rule ValidationRule) string {
+	if rule.PatternName != "" {
+		return fmt.Sprintf("value is not a valid %s", rule.PatternName)
+	}
+	return fmt.Sprintf("value does not match pattern %q", rule.Pattern.String())
+}
+
+// referenceValues returns the set of non-empty values in a column of a table
+func referenceValues(table *models.Table, column string) map[string]bool {
+	values := make(map[string]bool, len(table.Rows))
+	for _, row := range table.Rows {
+		if cell, ok := row.Values[column]; ok && !cell.IsEmpty() {
+			values[cell.RawValue] = true
+		}
+	}
+	return values
+}
+
 // validateCell validates a single cell against a rule
-func (v *Validator) validateCell(cell models.Cell, rule ValidationRule, rowIdx int) []ValidationError {
+func (v *Validator) validateCell(cell models.Cell, row models.Row, rule ValidationRule, rowIdx int) []ValidationError {
 	var errors []ValidationError
 	value := cell.RawValue
 
@@ -143,28 +382,44 @@ func (v *Validator) validateCell(cell models.Cell, rule ValidationRule, rowIdx i
 				Row:     rowIdx,
 				Column:  rule.Column,
 				Value:   value,
-				Message: fmt.Sprintf("value does not match pattern %q", rule.Pattern.String()),
+				Message: patternMessage(rule),
 			})
 		}
 	}
 
 	// Check numeric range
 	if rule.MinValSet || rule.MaxValSet {
-		if numVal, ok := cell.AsFloat(); ok {
-			if rule.MinValSet && numVal < rule.MinVal {
+		if numVal, ok := cell.AsFloat(); ok && (math.IsNaN(numVal) || math.IsInf(numVal, 0)) {
+			// NaN compares false against both bounds, so reject non-finite values outright
+			errors = append(errors, ValidationError{
+				Row:     rowIdx,
+				Column:  rule.Column,
+				Value:   value,
+				Message: fmt.Sprintf("value %v is not a finite number", numVal),
+			})
+		} else if ok {
+			if rule.MinValSet && (numVal < rule.MinVal || rule.MinExclusive && numVal == rule.MinVal) {
+				message := fmt.Sprintf("value %v is less than minimum %v", numVal, rule.MinVal)
+				if rule.MinExclusive {
+					message = fmt.Sprintf("value %v must be greater than %v", numVal, rule.MinVal)
+				}
 				errors = append(errors, ValidationError{
 					Row:     rowIdx,
 					Column:  rule.Column,
 					Value:   value,
-					Message: fmt.Sprintf("value %v is less than minimum %v", numVal, rule.MinVal),
+					Message: message,
 				})
 			}
-			if rule.MaxValSet && numVal > rule.MaxVal {
+			if rule.MaxValSet && (numVal > rule.MaxVal || rule.MaxExclusive && numVal == rule.MaxVal) {
+				message := fmt.Sprintf("value %v exceeds maximum %v", numVal, rule.MaxVal)
+				if rule.MaxExclusive {
+					message = fmt.Sprintf("value %v must be less than %v", numVal, rule.MaxVal)
+				}
 				errors = append(errors, ValidationError{
 					Row:     rowIdx,
 					Column:  rule.Column,
 					Value:   value,
-					Message: fmt.Sprintf("value %v exceeds maximum %v", numVal, rule.MaxVal),
+					Message: message,
 				})
 			}
 		} else if rule.MinValSet || rule.MaxValSet {
@@ -178,6 +433,43 @@ func (v *Validator) validateCell(cell models.Cell, rule ValidationRule, rowIdx i
 		}
 	}
 
+	// Check date range
+	if !rule.MinDate.IsZero() || !rule.MaxDate.IsZero() {
+		if date, ok := cellDate(cell); ok {
+			if !rule.MinDate.IsZero() && (date.Before(rule.MinDate) || rule.MinDateExclusive && date.Equal(rule.MinDate)) {
+				message := fmt.Sprintf("date %s is before %s", formatRuleDate(date), formatRuleDate(rule.MinDate))
+				if rule.MinDateExclusive {
+					message = fmt.Sprintf("date %s must be after %s", formatRuleDate(date), formatRuleDate(rule.MinDate))
+				}
+				errors = append(errors, ValidationError{
+					Row:     rowIdx,
+					Column:  rule.Column,
+					Value:   value,
+					Message: message,
+				})
+			}
+			if !rule.MaxDate.IsZero() && (date.After(rule.MaxDate) || rule.MaxDateExclusive && date.Equal(rule.MaxDate)) {
+				message := fmt.Sprintf("date %s is after %s", formatRuleDate(date), formatRuleDate(rule.MaxDate))
+				if rule.MaxDateExclusive {
+					message = fmt.Sprintf("date %s must be before %s", formatRuleDate(date), formatRuleDate(rule.MaxDate))
+				}
+				errors = append(errors, ValidationError{
+					Row:     rowIdx,
+					Column:  rule.Column,
+					Value:   value,
+					Message: message,
+				})
+			}
+		} else {
+			errors = append(errors, ValidationError{
+				Row:     rowIdx,
+				Column:  rule.Column,
+				Value:   value,
+				Message: "value is not a date but date range validation was specified",
+			})
+		}
+	}
+
 	// Check allowed values
 	if len(rule.AllowedValues) > 0 {
 		found := false
@@ -197,6 +489,16 @@ func (v *Validator) validateCell(cell models.Cell, rule ValidationRule, rowIdx i
 		}
 	}
 
+	// Check reference table values
+	if rule.refValues != nil && !rule.refValues[value] {
+		errors = append(errors, ValidationError{
+			Row:     rowIdx,
+			Column:  rule.Column,
+			Value:   value,
+			Message: fmt.Sprintf("value not found in column %q of table %q", rule.RefColumn, rule.RefTable.Name),
+		})
+	}
+
 	// Check custom function
 	if rule.CustomFunc != nil {
 		if err := rule.CustomFunc(cell); err != nil {
@@ -209,12 +511,55 @@ func (v *Validator) validateCell(cell models.Cell, rule ValidationRule, rowIdx i
 		}
 	}
 
+	// Check custom row function
+	if rule.CustomRowFunc != nil {
+		if err := rule.CustomRowFunc(row); err != nil {
+			errors = append(errors, ValidationError{
+				Row:     rowIdx,
+				Column:  rule.Column,
+				Value:   value,
+				Message: err.Error(),
+			})
+		}
+	}
+
 	return errors
 }
 
 // ValidateTable is a convenience function to validate a table with given rules
-func ValidateTable(table *models.Table, rules []ValidationRule) ValidationResult {
-	return NewValidator(rules).Validate(table)
+// and optional table-level rules
+func ValidateTable(table *models.Table, rules []ValidationRule, tableRules ...TableRule) ValidationResult {
+	return NewValidator(rules).AddTableRules(tableRules...).Validate(table)
+}
+
+// FilterInvalidRows returns a new table holding only the rows that fail at
+// least one of the rules, e.g. to quarantine bad records before export
+func FilterInvalidRows(table *models.Table, rules []ValidationRule) *models.Table {
+	return filterRows(table, rules, true)
+}
+
+// FilterValidRows returns a new table holding only the rows that pass every rule
+func FilterValidRows(table *models.Table, rules []ValidationRule) *models.Table {
+	return filterRows(table, rules, false)
+}
+
+// filterRows keeps the rows whose validation outcome matches invalid
+func filterRows(table *models.Table, rules []ValidationRule, invalid bool) *models.Table {
+	if table == nil {
+		return nil
+	}
+	failed := ValidateTable(table, rules).ErrorsByRow()
+
+	rows := make([]models.Row, 0, len(table.Rows))
+	for i, row := range table.Rows {
+		if _, bad := failed[i]; bad == invalid {
+			rows = append(rows, row)
+		}
+	}
+
+	result := *table
+	result.Rows = rows
+	return &result
 }
 
 // RuleBuilder provides a fluent API for building validation rules
@@ -258,18 +603,101 @@ func (rb *RuleBuilder) Range(min, max float64) *RuleBuilder {
 	return rb.Min(min).Max(max)
 }
 
+// RangeExclusive requires values strictly between min and max
+func (rb *RuleBuilder) RangeExclusive(min, max float64) *RuleBuilder {
+	rb.rule.MinExclusive = true
+	rb.rule.MaxExclusive = true
+	return rb.Min(min).Max(max)
+}
+
+// DateBefore requires dates strictly before t, e.g. time.Now() for "not in the future"
+func (rb *RuleBuilder) DateBefore(t time.Time) *RuleBuilder {
+	rb.rule.MaxDate = t
+	rb.rule.MaxDateExclusive = true
+	return rb
+}
+
+// DateAfter requires dates strictly after t
+func (rb *RuleBuilder) DateAfter(t time.Time) *RuleBuilder {
+	rb.rule.MinDate = t
+	rb.rule.MinDateExclusive = true
+	return rb
+}
+
+// DateBetween requires dates from min to max, inclusive
+func (rb *RuleBuilder) DateBetween(min, max time.Time) *RuleBuilder {
+	rb.rule.MinDate, rb.rule.MinDateExclusive = min, false
+	rb.rule.MaxDate, rb.rule.MaxDateExclusive = max, false
+	return rb
+}
+
 // OneOf restricts the value to a set of allowed values
 func (rb *RuleBuilder) OneOf(values ...string) *RuleBuilder {
 	rb.rule.AllowedValues = values
 	return rb
 }
 
+// OneOfTable restricts the value to those present in a column of another table.
+// The allowed set is read from refTable each time the rule is validated.
+func (rb *RuleBuilder) OneOfTable(refTable *models.Table, refColumn string) *RuleBuilder {
+	rb.rule.RefTable = refTable
+	rb.rule.RefColumn = refColumn
+	return rb
+}
+
+// Built-in patterns for common formats. They cover ASCII addresses only:
+// internationalized email addresses and domain names, quoted local parts, IP
+// literals and IPv6 hosts are out of scope and are rejected.
+var (
+	emailPattern = regexp.MustCompile(`^[A-Za-z0-9_%+-]+(?:\.[A-Za-z0-9_%+-]+)*@` +
+		`(?:[A-Za-z0-9](?:[A-Za-z0-9-]*[A-Za-z0-9])?\.)+[A-Za-z]{2,}$`)
+	urlPattern = regexp.MustCompile(`^(?i:https?)://` +
+		`(?:[A-Za-z0-9](?:[A-Za-z0-9-]*[A-Za-z0-9])?\.)*[A-Za-z0-9](?:[A-Za-z0-9-]*[A-Za-z0-9])?` +
+		`(?::[0-9]{1,5})?(?:[/?#]\S*)?$`)
+	uuidPattern = regexp.MustCompile(`^[0-9A-Fa-f]{8}-[0-9A-Fa-f]{4}-[0-9A-Fa-f]{4}-[0-9A-Fa-f]{4}-[0-9A-Fa-f]{12}$`)
+)
+
+// Email requires the value to be an email address such as "user@example.com"
+func (rb *RuleBuilder) Email() *RuleBuilder {
+	rb.rule.Pattern = emailPattern
+	rb.rule.PatternName = "email address"
+	return rb
+}
+
+// URL requires the value to be an absolute http or https URL
+func (rb *RuleBuilder) URL() *RuleBuilder {
+	rb.rule.Pattern = urlPattern
+	rb.rule.PatternName = "URL"
+	return rb
+}
+
+// UUID requires the value to be a UUID in 8-4-4-4-12 hex form
+func (rb *RuleBuilder) UUID() *RuleBuilder {
+	rb.rule.Pattern = uuidPattern
+	rb.rule.PatternName = "UUID"
+	return rb
+}
+
 // Custom adds a custom validation function
 func (rb *RuleBuilder) Custom(fn func(cell models.Cell) error) *RuleBuilder {
 	rb.rule.CustomFunc = fn
 	return rb
 }
 
+// CustomRow adds a custom validation function that receives the full row,
+// for checks that depend on other columns
+func (rb *RuleBuilder) CustomRow(fn func(row models.Row) error) *RuleBuilder {
+	rb.rule.CustomRowFunc = fn
+	return rb
+}
+
+// Message sets a template used as the message of every failure of the rule;
+// see ValidationRule.MessageTemplate for the placeholders
+func (rb *RuleBuilder) Message(template string) *RuleBuilder {
+	rb.rule.MessageTemplate = template
+	return rb
+}
+
 // Build returns the constructed ValidationRule
 func (rb *RuleBuilder) Build() ValidationRule {
 	return rb.rule
@@ -3,9 +3,13 @@ package validation
 import (
 	"errors"
 	"fmt"
+	"math"
 	"regexp"
+	"strings"
 	"testing"
+	"time"
 
+	"github.com/meddhiazoghlami/goxls/pkg/export"
 	"github.com/meddhiazoghlami/goxls/pkg/models"
 )
 
@@ -76,6 +80,10 @@ func createCell(value interface{}, row, col int) models.Cell {
 			cell.RawValue = "false"
 		}
 		cell.Type = models.CellTypeBool
+	case time.Time:
+		cell.Value = v
+		cell.RawValue = v.Format("2006-01-02")
+		cell.Type = models.CellTypeDate
 	}
 
 	return cell
@@ -198,6 +206,127 @@ func TestValidator_Validate_MinMax(t *testing.T) {
 	}
 }
 
+func TestValidator_Validate_RangeExclusive(t *testing.T) {
+	table := createTestTable(
+		[]string{"Score"},
+		[][]interface{}{
+			{float64(0)},   // Equal to minimum
+			{float64(0.5)}, // Inside
+			{float64(1)},   // Equal to maximum
+		},
+	)
+
+	inclusive := NewValidator([]ValidationRule{ForColumn("Score").Range(0, 1).Build()}).Validate(table)
+	if !inclusive.Valid {
+		t.Errorf("Range(0, 1) errors = %v, want none", inclusive.Errors)
+	}
+
+	exclusive := NewValidator([]ValidationRule{ForColumn("Score").RangeExclusive(0, 1).Build()}).Validate(table)
+	byRow := exclusive.ErrorsByRow()
+	if len(exclusive.Errors) != 2 || len(byRow[0]) != 1 || len(byRow[2]) != 1 {
+		t.Errorf("RangeExclusive(0, 1) errors = %v, want one each for rows 0 and 2", exclusive.Errors)
+	}
+}
+
+func TestValidator_Validate_RangeNonFinite(t *testing.T) {
+	table := createTestTable(
+		[]string{"Score"},
+		[][]interface{}{
+			{math.NaN()},
+			{math.Inf(1)},
+			{float64(5)},
+		},
+	)
+
+	result := NewValidator([]ValidationRule{ForColumn("Score").Min(0).Build()}).Validate(table)
+	byRow := result.ErrorsByRow()
+	if len(result.Errors) != 2 || len(byRow[0]) != 1 || len(byRow[1]) != 1 {
+		t.Fatalf("Min(0) errors = %v, want one each for the NaN and Inf rows", result.Errors)
+	}
+	if !strings.Contains(byRow[0][0].Message, "not a finite number") {
+		t.Errorf("NaN error message = %q, want it to mention a finite number", byRow[0][0].Message)
+	}
+}
+
+func TestValidator_Validate_MessageTemplate(t *testing.T) {
+	table := createTestTable(
+		[]string{"Age"},
+		[][]interface{}{
+			{float64(16)},
+			{float64(30)},
+			{float64(130)},
+		},
+	)
+
+	rules := []ValidationRule{
+		ForColumn("Age").Range(18, 120).Message("{column} {value} below minimum {min}").Build(),
+	}
+	result := NewValidator(rules).Validate(table)
+	if len(result.Errors) != 2 {
+		t.Fatalf("Errors = %v, want 2", result.Errors)
+	}
+	if got := result.Errors[0].Message; got != "Age 16 below minimum 18" {
+		t.Errorf("Message = %q, want %q", got, "Age 16 below minimum 18")
+	}
+
+	rules[0].MessageTemplate = "row {row} ({cell}): {value} not in [{min}, {max}]"
+	result = NewValidator(rules).Validate(table)
+	if got, want := result.Errors[1].Message, "row 2 (A3): 130 not in [18, 120]"; got != want {
+		t.Errorf("Message = %q, want %q", got, want)
+	}
+
+	rules[0].MessageTemplate = ""
+	result = NewValidator(rules).Validate(table)
+	if got := result.Errors[0].Message; got != "value 16 is less than minimum 18" {
+		t.Errorf("default Message = %q, want %q", got, "value 16 is less than minimum 18")
+	}
+}
+
+func TestValidator_Validate_DateRules(t *testing.T) {
+	now := time.Now()
+	table := createTestTable(
+		[]string{"DOB", "Expiry"},
+		[][]interface{}{
+			{time.Date(1990, 5, 17, 0, 0, 0, 0, time.UTC), "2031-01-01"},
+			{now.AddDate(1, 0, 0), "2024-06-30"},
+			{nil, "not a date"},
+		},
+	)
+
+	rules := []ValidationRule{ForColumn("DOB").DateBefore(now).Build()}
+	result := NewValidator(rules).Validate(table)
+	if len(result.Errors) != 1 || result.Errors[0].Row != 1 {
+		t.Fatalf("DateBefore(now) errors = %v, want only the future DOB in row 1", result.Errors)
+	}
+	if !strings.Contains(result.Errors[0].Message, "must be before") {
+		t.Errorf("Message = %q, want it to say the date must be before now", result.Errors[0].Message)
+	}
+
+	rules = []ValidationRule{ForColumn("DOB").Required().DateBefore(now).Build()}
+	if result := NewValidator(rules).Validate(table); len(result.Errors) != 2 {
+		t.Errorf("required DateBefore(now) errors = %v, want the future and empty DOBs", result.Errors)
+	}
+
+	cutoff := time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC)
+	rules = []ValidationRule{ForColumn("Expiry").DateAfter(cutoff).Build()}
+	byRow := NewValidator(rules).Validate(table).ErrorsByRow()
+	if len(byRow) != 2 || len(byRow[1]) != 1 || len(byRow[2]) != 1 {
+		t.Errorf("DateAfter errors = %v, want the parsed 2024 date and the non-date", byRow)
+	}
+	if msg := byRow[2][0].Message; !strings.Contains(msg, "not a date") {
+		t.Errorf("non-date Message = %q, want it to say the value is not a date", msg)
+	}
+
+	rules = []ValidationRule{ForColumn("Expiry").
+		DateBetween(time.Date(2024, 6, 30, 0, 0, 0, 0, time.UTC), time.Date(2031, 1, 1, 0, 0, 0, 0, time.UTC)).
+		Message("{value} outside {min} to {max}").
+		Build()}
+	result = NewValidator(rules).Validate(table)
+	if len(result.Errors) != 1 || result.Errors[0].Message != "not a date outside 2024-06-30 to 2031-01-01" {
+		t.Errorf("DateBetween errors = %v, want only the non-date with both bounds inclusive", result.Errors)
+	}
+}
+
 func TestValidator_Validate_AllowedValues(t *testing.T) {
 	table := createTestTable(
 		[]string{"Status"},
@@ -228,6 +357,58 @@ func TestValidator_Validate_AllowedValues(t *testing.T) {
 	}
 }
 
+func TestValidator_Validate_OneOfTable(t *testing.T) {
+	statusCodes := createTestTable(
+		[]string{"Code", "Description"},
+		[][]interface{}{
+			{"active", "Currently active"},
+			{"inactive", "No longer active"},
+		},
+	)
+	statusCodes.Name = "StatusCodes"
+
+	table := createTestTable(
+		[]string{"Name", "Status"},
+		[][]interface{}{
+			{"Alice", "active"},
+			{"Bob", "deleted"}, // Not in StatusCodes
+			{"Carol", "inactive"},
+			{"Dave", ""}, // Empty, not required
+		},
+	)
+
+	rule := ForColumn("Status").OneOfTable(statusCodes, "Code").Build()
+	result := NewValidator([]ValidationRule{rule}).Validate(table)
+
+	if result.Valid {
+		t.Fatal("Expected validation to fail for value missing from reference table")
+	}
+	if len(result.Errors) != 1 {
+		t.Fatalf("Expected 1 error, got %d: %v", len(result.Errors), result.Errors)
+	}
+	if result.Errors[0].Row != 1 || result.Errors[0].Value != "deleted" {
+		t.Errorf("Unexpected error: %+v", result.Errors[0])
+	}
+	if !strings.Contains(result.Errors[0].Message, "StatusCodes") {
+		t.Errorf("Message = %q, want it to name the reference table", result.Errors[0].Message)
+	}
+}
+
+func TestValidator_Validate_OneOfTable_ReadsAtValidationTime(t *testing.T) {
+	ref := createTestTable([]string{"Code"}, [][]interface{}{{"a"}})
+	table := createTestTable([]string{"Code"}, [][]interface{}{{"b"}})
+
+	v := NewValidator([]ValidationRule{ForColumn("Code").OneOfTable(ref, "Code").Build()})
+	if v.Validate(table).Valid {
+		t.Fatal("Expected b to be rejected before it is added to the reference table")
+	}
+
+	ref.Rows = append(ref.Rows, createTestTable([]string{"Code"}, [][]interface{}{{"b"}}).Rows...)
+	if result := v.Validate(table); !result.Valid {
+		t.Errorf("Expected b to pass after being added, got %v", result.Errors)
+	}
+}
+
 func TestValidator_Validate_CustomFunc(t *testing.T) {
 	table := createTestTable(
 		[]string{"Code"},
@@ -264,6 +445,59 @@ func TestValidator_Validate_CustomFunc(t *testing.T) {
 	}
 }
 
+func TestValidator_Validate_CustomRowFunc(t *testing.T) {
+	table := createTestTable(
+		[]string{"Price", "Quantity", "Total"},
+		[][]interface{}{
+			{10.0, 2, 20.0},
+			{5.0, 3, 16.0}, // Should fail - 5 * 3 != 16
+			{2.5, 4, 10.0},
+		},
+	)
+
+	rule := ForColumn("Total").CustomRow(func(row models.Row) error {
+		price, _ := row.Get("Price")
+		qty, _ := row.Get("Quantity")
+		total, _ := row.Get("Total")
+		p, _ := price.AsFloat()
+		q, _ := qty.AsFloat()
+		if tot, _ := total.AsFloat(); tot != p*q {
+			return fmt.Errorf("total %v does not equal price * quantity (%v)", tot, p*q)
+		}
+		return nil
+	}).Build()
+
+	result := NewValidator([]ValidationRule{rule}).Validate(table)
+
+	if result.Valid {
+		t.Fatal("Expected validation to fail for mismatched total")
+	}
+	if len(result.Errors) != 1 {
+		t.Fatalf("Expected 1 error, got %d", len(result.Errors))
+	}
+	if result.Errors[0].Row != 1 || result.Errors[0].Column != "Total" || result.Errors[0].Value != "16" {
+		t.Errorf("Unexpected error: %+v", result.Errors[0])
+	}
+}
+
+func TestValidator_Validate_CustomFuncAndRowFunc(t *testing.T) {
+	table := createTestTable(
+		[]string{"Code"},
+		[][]interface{}{{"abc"}},
+	)
+
+	rule := ValidationRule{
+		Column:        "Code",
+		CustomFunc:    func(cell models.Cell) error { return errors.New("cell check") },
+		CustomRowFunc: func(row models.Row) error { return errors.New("row check") },
+	}
+
+	result := NewValidator([]ValidationRule{rule}).Validate(table)
+	if len(result.Errors) != 2 {
+		t.Fatalf("Expected both custom functions to report, got %d errors", len(result.Errors))
+	}
+}
+
 func TestValidator_Validate_NonexistentColumn(t *testing.T) {
 	table := createTestTable(
 		[]string{"Name"},
@@ -356,6 +590,62 @@ func TestValidationResult_ErrorsByRow(t *testing.T) {
 	}
 }
 
+func TestValidate_CellRef(t *testing.T) {
+	// Table read from a sheet: header in row 3 starting at column B, data skips an empty row
+	table := &models.Table{
+		Headers:   []string{"Name", "Age"},
+		StartRow:  2,
+		StartCol:  1,
+		HeaderRow: 2,
+		Rows: []models.Row{
+			{Index: 3, Values: map[string]models.Cell{
+				"Name": {Type: models.CellTypeString, RawValue: "Alice"},
+				"Age":  {Type: models.CellTypeNumber, Value: 30.0, RawValue: "30"},
+			}},
+			{Index: 5, Values: map[string]models.Cell{
+				"Name": {Type: models.CellTypeString, RawValue: "Bob"},
+				"Age":  {Type: models.CellTypeNumber, Value: 200.0, RawValue: "200"},
+			}},
+		},
+	}
+
+	result := ValidateTable(table, []ValidationRule{ForColumn("Age").Max(120).Build()})
+	if len(result.Errors) != 1 {
+		t.Fatalf("Expected 1 error, got %d", len(result.Errors))
+	}
+	if result.Errors[0].CellRef != "C6" {
+		t.Errorf("CellRef = %q, want C6", result.Errors[0].CellRef)
+	}
+	if !strings.Contains(result.Errors[0].Error(), "(C6)") {
+		t.Errorf("Error() = %q, want it to mention C6", result.Errors[0].Error())
+	}
+}
+
+func TestValidate_CellRef_HeaderCells(t *testing.T) {
+	// Header cells take precedence when columns were removed (e.g., hidden columns)
+	table := &models.Table{
+		Headers: []string{"Name", "Email"},
+		HeaderCells: []models.Cell{
+			{RawValue: "Name", Row: 0, Col: 0},
+			{RawValue: "Email", Row: 0, Col: 3},
+		},
+		Rows: []models.Row{
+			{Index: 1, Values: map[string]models.Cell{
+				"Name":  {Type: models.CellTypeString, RawValue: "Alice"},
+				"Email": {Type: models.CellTypeEmpty},
+			}},
+		},
+	}
+
+	result := ValidateTable(table, []ValidationRule{ForColumn("Email").Required().Build()})
+	if len(result.Errors) != 1 {
+		t.Fatalf("Expected 1 error, got %d", len(result.Errors))
+	}
+	if result.Errors[0].CellRef != "D2" {
+		t.Errorf("CellRef = %q, want D2", result.Errors[0].CellRef)
+	}
+}
+
 func TestValidationError_Error(t *testing.T) {
 	err := ValidationError{
 		Row:     5,
@@ -397,6 +687,86 @@ func TestRuleBuilder_MatchesPattern(t *testing.T) {
 	}
 }
 
+func TestRuleBuilder_FormatRules(t *testing.T) {
+	tests := []struct {
+		name    string
+		rule    ValidationRule
+		valid   []string
+		invalid []string
+		message string
+	}{
+		{
+			name:  "Email",
+			rule:  ForColumn("Value").Email().Build(),
+			valid: []string{"user@example.com", "first.last+tag@mail.example.co.uk", "a_b%c-d@x-y.org"},
+			invalid: []string{"plain", "user@", "@example.com", "user@example", "user..dots@example.com",
+				".user@example.com", "user@-example.com", "user name@example.com",
+				// Out of scope: internationalized addresses and domains
+				"üser@example.com", "user@bücher.de"},
+			message: "value is not a valid email address",
+		},
+		{
+			name:  "URL",
+			rule:  ForColumn("Value").URL().Build(),
+			valid: []string{"https://example.com", "http://localhost:8080/path?q=1#frag", "HTTPS://sub.example.org/a/b"},
+			invalid: []string{"example.com", "ftp://example.com", "https://", "https://exa mple.com", "https://-bad.com",
+				// Out of scope: IPv6 and internationalized hosts
+				"http://[::1]/", "https://bücher.de"},
+			message: "value is not a valid URL",
+		},
+		{
+			name:    "UUID",
+			rule:    ForColumn("Value").UUID().Build(),
+			valid:   []string{"123e4567-e89b-12d3-a456-426614174000", "A987FBC9-4BED-3078-CF07-9141BA07C9F3"},
+			invalid: []string{"123e4567e89b12d3a456426614174000", "{123e4567-e89b-12d3-a456-426614174000}", "123e4567-e89b-12d3-a456-42661417400g", ""},
+			message: "value is not a valid UUID",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var data [][]interface{}
+			for _, v := range append(append([]string{}, tt.valid...), tt.invalid...) {
+				if v == "" {
+					data = append(data, []interface{}{nil})
+				} else {
+					data = append(data, []interface{}{v})
+				}
+			}
+			result := NewValidator([]ValidationRule{tt.rule}).Validate(createTestTable([]string{"Value"}, data))
+
+			failed := make(map[int]bool)
+			for _, err := range result.Errors {
+				failed[err.Row] = true
+				if err.Message != tt.message {
+					t.Errorf("Message = %q, want %q", err.Message, tt.message)
+				}
+			}
+			for i, v := range tt.valid {
+				if failed[i] {
+					t.Errorf("%q should be valid", v)
+				}
+			}
+			for i, v := range tt.invalid {
+				// Empty cells are skipped unless the rule is Required
+				if failed[len(tt.valid)+i] == (v == "") {
+					t.Errorf("%q: failed = %v, want %v", v, failed[len(tt.valid)+i], v != "")
+				}
+			}
+		})
+	}
+}
+
+func TestRuleBuilder_EmailRequired(t *testing.T) {
+	table := createTestTable([]string{"Email"}, [][]interface{}{{"user@example.com"}, {nil}})
+
+	result := NewValidator([]ValidationRule{ForColumn("Email").Required().Email().Build()}).Validate(table)
+
+	if len(result.Errors) != 1 || result.Errors[0].Row != 1 || result.Errors[0].Message != "required field is empty" {
+		t.Errorf("Errors = %v, want one required error on row 1", result.Errors)
+	}
+}
+
 func TestRuleBuilder_Range(t *testing.T) {
 	rule := ForColumn("Age").Range(0, 150).Build()
 
@@ -491,6 +861,158 @@ func TestValidateTable(t *testing.T) {
 	}
 }
 
+func TestFilterInvalidRows(t *testing.T) {
+	table := createTestTable(
+		[]string{"Name", "Age"},
+		[][]interface{}{
+			{"Alice", 30},
+			{"Bob", 150},
+			{"Charlie", 25},
+			{"Dave", -1},
+		},
+	)
+	rules := []ValidationRule{ForColumn("Age").Range(0, 120).Build()}
+
+	invalid := FilterInvalidRows(table, rules)
+	csv, err := export.ToCSV(invalid)
+	if err != nil {
+		t.Fatalf("ToCSV() error = %v", err)
+	}
+	want := "Name,Age\nBob,150\nDave,-1\n"
+	if csv != want {
+		t.Errorf("ToCSV(FilterInvalidRows()) = %q, want %q", csv, want)
+	}
+
+	valid := FilterValidRows(table, rules)
+	if got := valid.ColumnStrings("Name"); strings.Join(got, ",") != "Alice,Charlie" {
+		t.Errorf("FilterValidRows() names = %v, want [Alice Charlie]", got)
+	}
+	if table.RowCount() != 4 {
+		t.Errorf("source table row count = %d, want 4", table.RowCount())
+	}
+	if FilterInvalidRows(nil, rules) != nil {
+		t.Error("FilterInvalidRows(nil) should return nil")
+	}
+}
+
+// =============================================================================
+// Table Rule Tests
+// =============================================================================
+
+func TestValidateTable_RequireMinRows(t *testing.T) {
+	table := createTestTable(
+		[]string{"Name"},
+		[][]interface{}{{"Alice"}, {"Bob"}},
+	)
+
+	result := ValidateTable(table, nil, RequireMinRows(3))
+	if result.Valid {
+		t.Fatal("Expected validation to fail for too few rows")
+	}
+	if len(result.Errors) != 1 {
+		t.Fatalf("Expected 1 error, got %d", len(result.Errors))
+	}
+	if result.Errors[0].Row != -1 {
+		t.Errorf("Row = %d, want -1 for table rule", result.Errors[0].Row)
+	}
+	if got := result.Errors[0].Error(); got != "table: table has 2 rows, want at least 3" {
+		t.Errorf("Error() = %q", got)
+	}
+
+	if result := ValidateTable(table, nil, RequireMinRows(2)); !result.Valid {
+		t.Errorf("Expected 2 rows to satisfy RequireMinRows(2), got %v", result.Errors)
+	}
+}
+
+func TestValidateTable_RequireMinRows_EmptyTable(t *testing.T) {
+	empty := createTestTable([]string{"Name"}, nil)
+
+	if result := ValidateTable(empty, nil, RequireMinRows(1)); result.Valid {
+		t.Error("Expected empty table to fail RequireMinRows(1)")
+	}
+	if result := ValidateTable(nil, nil, RequireMinRows(1)); result.Valid {
+		t.Error("Expected nil table to fail RequireMinRows(1)")
+	}
+}
+
+func TestValidateTable_RequireExactRows(t *testing.T) {
+	table := createTestTable(
+		[]string{"Month"},
+		[][]interface{}{{"Jan"}, {"Feb"}, {"Mar"}},
+	)
+
+	tests := []struct {
+		n     int
+		valid bool
+	}{
+		{3, true},
+		{2, false},
+		{12, false},
+	}
+
+	for _, tt := range tests {
+		result := ValidateTable(table, nil, RequireExactRows(tt.n))
+		if result.Valid != tt.valid {
+			t.Errorf("RequireExactRows(%d) Valid = %v, want %v", tt.n, result.Valid, tt.valid)
+		}
+	}
+}
+
+func TestValidateTable_ColumnSum(t *testing.T) {
+	table := createTestTable(
+		[]string{"Invoice", "Amount"},
+		[][]interface{}{{"INV-1", 4000.0}, {"INV-2", 5999.5}, {"INV-3", nil}, {"INV-4", "n/a"}},
+	)
+
+	tests := []struct {
+		name  string
+		rule  TableRule
+		valid bool
+	}{
+		{"equals within tolerance", ColumnSumEquals("Amount", 10000, 1), true},
+		{"equals without tolerance", ColumnSumEquals("Amount", 10000, 0), false},
+		{"equals exact", ColumnSumEquals("Amount", 9999.5, 0), true},
+		{"in range", ColumnSumInRange("Amount", 9000, 10000), true},
+		{"below range", ColumnSumInRange("Amount", 10000, 11000), false},
+		{"missing column", ColumnSumEquals("Total", 0, 0), false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			result := ValidateTable(table, nil, tt.rule)
+			if result.Valid != tt.valid {
+				t.Errorf("Valid = %v, want %v (errors: %v)", result.Valid, tt.valid, result.Errors)
+			}
+		})
+	}
+
+	result := ValidateTable(table, nil, ColumnSumEquals("Amount", 10000, 0))
+	if len(result.Errors) != 1 || result.Errors[0].Message != "sum of Amount is 9999.5, want 10000 (tolerance 0)" {
+		t.Errorf("Errors = %v", result.Errors)
+	}
+	if result := ValidateTable(nil, nil, ColumnSumEquals("Amount", 0, 0)); result.Valid {
+		t.Error("Expected nil table to fail ColumnSumEquals")
+	}
+}
+
+func TestValidator_AddTableRules_WithColumnRules(t *testing.T) {
+	table := createTestTable(
+		[]string{"Name"},
+		[][]interface{}{{""}},
+	)
+
+	v := NewValidator([]ValidationRule{{Column: "Name", Required: true}}).
+		AddTableRules(RequireMinRows(2))
+	result := v.Validate(table)
+
+	if len(result.Errors) != 2 {
+		t.Fatalf("Expected 1 table error and 1 cell error, got %d", len(result.Errors))
+	}
+	if result.Errors[0].Row != -1 || result.Errors[1].Column != "Name" {
+		t.Errorf("Unexpected errors: %v", result.Errors)
+	}
+}
+
 // =============================================================================
 // Edge Cases
 // =============================================================================